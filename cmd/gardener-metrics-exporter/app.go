@@ -20,12 +20,15 @@ import (
 	"io/ioutil"
 	"net"
 	"os"
+	"time"
 
 	"github.com/gardener/gardener-metrics-exporter/pkg/metrics"
 	"github.com/gardener/gardener-metrics-exporter/pkg/server"
 	"github.com/gardener/gardener-metrics-exporter/pkg/version"
 	clientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -37,9 +40,18 @@ import (
 var log *logrus.Logger
 
 type options struct {
-	bindAddress    string
-	port           int
-	kubeconfigPath string
+	bindAddress                 string
+	port                        int
+	kubeconfigPath              string
+	collectInterval             time.Duration
+	seedConditionStaleThreshold time.Duration
+	forcedUpgradeWindow         time.Duration
+	onlyRecentlyChanged         time.Duration
+	shardIndex                  int
+	shardTotal                  int
+	environmentLabel            string
+	conditionStatusSeries       bool
+	healthScoreWeights          map[string]int
 }
 
 func (o *options) validate() bool {
@@ -62,6 +74,48 @@ func (o *options) validate() bool {
 		log.Errorf("port is out of range: %d", o.port)
 		return false
 	}
+
+	// Validate that the collect interval is not negative.
+	if o.collectInterval < 0 {
+		log.Errorf("collect-interval must not be negative: %s", o.collectInterval)
+		return false
+	}
+
+	// Validate that the seed condition stale threshold is positive.
+	if o.seedConditionStaleThreshold <= 0 {
+		log.Errorf("seed-condition-stale-threshold must be positive: %s", o.seedConditionStaleThreshold)
+		return false
+	}
+
+	// Validate that the forced upgrade window is not negative.
+	if o.forcedUpgradeWindow < 0 {
+		log.Errorf("forced-upgrade-window must not be negative: %s", o.forcedUpgradeWindow)
+		return false
+	}
+
+	// Validate that the window for recently changed Shoots is not negative.
+	if o.onlyRecentlyChanged < 0 {
+		log.Errorf("only-recently-changed must not be negative: %s", o.onlyRecentlyChanged)
+		return false
+	}
+
+	// Validate that the shard index is in range of the shard total.
+	if o.shardTotal < 1 {
+		log.Errorf("shard-total must be at least 1: %d", o.shardTotal)
+		return false
+	}
+	if o.shardIndex < 0 || o.shardIndex >= o.shardTotal {
+		log.Errorf("shard-index is out of range [0, %d): %d", o.shardTotal, o.shardIndex)
+		return false
+	}
+
+	// Validate that the health score weights are not negative.
+	for conditionType, weight := range o.healthScoreWeights {
+		if weight < 0 {
+			log.Errorf("health-score-weights must not be negative: %s=%d", conditionType, weight)
+			return false
+		}
+	}
 	return true
 }
 
@@ -86,6 +140,15 @@ func NewStartGardenMetricsExporter(ctx context.Context, logger *logrus.Logger) *
 	cmd.Flags().StringVar(&options.bindAddress, "bind-address", "0.0.0.0", "bind address for the webserver")
 	cmd.Flags().IntVar(&options.port, "port", 2718, "port for the webserver")
 	cmd.Flags().StringVar(&options.kubeconfigPath, "kubeconfig", "", "path to kubeconfig file for a Garden cluster")
+	cmd.Flags().DurationVar(&options.collectInterval, "collect-interval", 0, "interval to recompute the metrics in the background, independent of scrapes (0 computes them on each scrape)")
+	cmd.Flags().DurationVar(&options.seedConditionStaleThreshold, "seed-condition-stale-threshold", 5*time.Minute, "duration after which a not updated Seed condition is considered stale")
+	cmd.Flags().DurationVar(&options.forcedUpgradeWindow, "forced-upgrade-window", 7*24*time.Hour, "duration before the expiration of a Kubernetes version in which a forced Shoot upgrade is considered imminent")
+	cmd.Flags().DurationVar(&options.onlyRecentlyChanged, "only-recently-changed", 0, "restrict the detailed Shoot metrics to Shoots which have changed within the given window (0 exposes them for all Shoots)")
+	cmd.Flags().IntVar(&options.shardIndex, "shard-index", 0, "index of the shard of Shoots which is handled by this exporter replica")
+	cmd.Flags().IntVar(&options.shardTotal, "shard-total", 1, "total count of shards the Shoots are distributed to")
+	cmd.Flags().StringVar(&options.environmentLabel, "environment-label", "", "label on the project namespaces which contains the environment of the Shoots (empty disables the environment metric)")
+	cmd.Flags().BoolVar(&options.conditionStatusSeries, "condition-status-series", false, "additionally expose the Shoot and Seed conditions as one series per status, like the kube-state-metrics condition metrics")
+	cmd.Flags().StringToIntVar(&options.healthScoreWeights, "health-score-weights", nil, "weights of condition and constraint types for the Shoot health score, e.g. APIServerAvailable=3,EveryNodeReady=2 (other types have the weight 1)")
 	return cmd
 }
 
@@ -93,30 +156,61 @@ func run(ctx context.Context, o *options) error {
 	stopCh := make(chan struct{})
 
 	// Create informer factories to create informers.
-	gardenInformerFactory, err := setupInformerFactories(o.kubeconfigPath, stopCh)
+	gardenInformerFactory, kubeInformerFactory, err := setupInformerFactories(o.kubeconfigPath, stopCh)
 	if err != nil {
 		return err
 	}
 
 	// Create informers.
 	var (
-		shootInformer   = gardenInformerFactory.Core().V1beta1().Shoots().Informer()
-		seedInformer    = gardenInformerFactory.Core().V1beta1().Seeds().Informer()
-		projectInformer = gardenInformerFactory.Core().V1beta1().Projects().Informer()
-		plantInformer   = gardenInformerFactory.Core().V1beta1().Plants().Informer()
+		gardenInformers                = gardenInformerFactory.Core().V1beta1()
+		shootInformer                  = gardenInformers.Shoots().Informer()
+		seedInformer                   = gardenInformers.Seeds().Informer()
+		projectInformer                = gardenInformers.Projects().Informer()
+		plantInformer                  = gardenInformers.Plants().Informer()
+		cloudProfileInformer           = gardenInformers.CloudProfiles().Informer()
+		controllerInstallationInformer = gardenInformers.ControllerInstallations().Informer()
+		controllerRegistrationInformer = gardenInformers.ControllerRegistrations().Informer()
+		backupBucketInformer           = gardenInformers.BackupBuckets().Informer()
+		backupEntryInformer            = gardenInformers.BackupEntries().Informer()
+		quotaInformer                  = gardenInformers.Quotas().Informer()
+		secretBindingInformer          = gardenInformers.SecretBindings().Informer()
+
+		kubeInformers         = kubeInformerFactory.Core().V1()
+		resourceQuotaInformer = kubeInformers.ResourceQuotas().Informer()
+		kubeInformersSynced   = []cache.InformerSynced{resourceQuotaInformer.HasSynced}
 	)
 
+	// Namespaces are only watched if the environment metric is enabled.
+	if o.environmentLabel != "" {
+		kubeInformersSynced = append(kubeInformersSynced, kubeInformers.Namespaces().Informer().HasSynced)
+	}
+
 	// Start the factories and wait until the creates informes has synce
 	gardenInformerFactory.Start(stopCh)
-	if !cache.WaitForCacheSync(ctx.Done(), shootInformer.HasSynced, seedInformer.HasSynced, projectInformer.HasSynced, plantInformer.HasSynced) {
+	kubeInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(ctx.Done(), shootInformer.HasSynced, seedInformer.HasSynced, projectInformer.HasSynced, plantInformer.HasSynced, cloudProfileInformer.HasSynced, controllerInstallationInformer.HasSynced, controllerRegistrationInformer.HasSynced, backupBucketInformer.HasSynced, backupEntryInformer.HasSynced, quotaInformer.HasSynced, secretBindingInformer.HasSynced) {
 		return errors.New("Timed out waiting for Garden caches to sync")
 	}
+	if !cache.WaitForCacheSync(ctx.Done(), kubeInformersSynced...) {
+		return errors.New("Timed out waiting for Kubernetes caches to sync")
+	}
 
 	// Start the metrics collector
-	metrics.SetupMetricsCollector(gardenInformerFactory.Core().V1beta1().Shoots(), gardenInformerFactory.Core().V1beta1().Seeds(), gardenInformerFactory.Core().V1beta1().Projects(), gardenInformerFactory.Core().V1beta1().Plants(), log)
+	explainHandler := metrics.SetupMetricsCollector(ctx, gardenInformers, kubeInformers, metrics.Options{
+		CollectInterval:             o.collectInterval,
+		SeedConditionStaleThreshold: o.seedConditionStaleThreshold,
+		ForcedUpgradeWindow:         o.forcedUpgradeWindow,
+		OnlyRecentlyChanged:         o.onlyRecentlyChanged,
+		ShardIndex:                  o.shardIndex,
+		ShardTotal:                  o.shardTotal,
+		EnvironmentLabel:            o.environmentLabel,
+		ConditionStatusSeries:       o.conditionStatusSeries,
+		HealthScoreWeights:          o.healthScoreWeights,
+	}, log)
 
 	// Start the webserver.
-	go server.Serve(ctx, o.bindAddress, o.port, log, stopCh)
+	go server.Serve(ctx, o.bindAddress, o.port, explainHandler, log, stopCh)
 
 	<-stopCh
 	log.Info("App shut down.")
@@ -155,22 +249,27 @@ func newClientConfig(kubeconfigPath string) (*rest.Config, error) {
 	return client, nil
 }
 
-func setupInformerFactories(kubeconfigPath string, stopCh <-chan struct{}) (gardencoreinformers.SharedInformerFactory, error) {
+func setupInformerFactories(kubeconfigPath string, stopCh <-chan struct{}) (gardencoreinformers.SharedInformerFactory, kubeinformers.SharedInformerFactory, error) {
 	restConfig, err := newClientConfig(kubeconfigPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if restConfig == nil {
-		return nil, err
+		return nil, nil, err
 	}
 	gardenClient, err := clientset.NewForConfig(restConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if gardenClient == nil {
-		return nil, errors.New("gardenClient is nil")
+		return nil, nil, errors.New("gardenClient is nil")
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, err
 	}
 	gardenInformerFactory := gardencoreinformers.NewSharedInformerFactory(gardenClient, 0)
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
 
-	return gardenInformerFactory, nil
+	return gardenInformerFactory, kubeInformerFactory, nil
 }
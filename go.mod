@@ -5,8 +5,11 @@ go 1.13
 require (
 	github.com/gardener/gardener v1.4.0
 	github.com/prometheus/client_golang v1.3.0
+	github.com/prometheus/client_model v0.1.0
+	github.com/robfig/cron v1.2.0
 	github.com/sirupsen/logrus v1.4.2
 	github.com/spf13/cobra v0.0.6
+	k8s.io/api v0.16.8
 	k8s.io/apimachinery v0.17.0
 	k8s.io/client-go v11.0.1-0.20190409021438-1a26190bd76a+incompatible
 )
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// collectBackupBucketMetrics collects BackupBucket metrics.
+func (c gardenMetricsCollector) collectBackupBucketMetrics(ch chan<- prometheus.Metric) {
+	backupBuckets, err := c.backupBucketInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "backupbuckets"}).Inc()
+		return
+	}
+
+	for _, backupBucket := range backupBuckets {
+		seed := unknown
+		if backupBucket.Spec.SeedName != nil {
+			seed = *backupBucket.Spec.SeedName
+		}
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenBackupBucketInfo], prometheus.GaugeValue, 0, backupBucket.Name, backupBucket.Spec.Provider.Type, backupBucket.Spec.Provider.Region, seed)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "backupbuckets"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		// BackupBuckets have no conditions, their health is reported via the last operation and the last error.
+		if lastOperation := backupBucket.Status.LastOperation; lastOperation != nil {
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenBackupBucketOperationState], prometheus.GaugeValue, mapOperationState(lastOperation.State), backupBucket.Name, string(lastOperation.Type))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "backupbuckets"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		if lastError := backupBucket.Status.LastError; lastError != nil {
+			codes := make([]string, 0, len(lastError.Codes))
+			for _, code := range lastError.Codes {
+				codes = append(codes, string(code))
+			}
+			if len(codes) == 0 {
+				codes = append(codes, unknown)
+			}
+			for _, code := range codes {
+				metric, err := prometheus.NewConstMetric(c.descs[metricGardenBackupBucketLastError], prometheus.GaugeValue, 1, backupBucket.Name, code)
+				if err != nil {
+					ScrapeFailures.With(prometheus.Labels{"kind": "backupbuckets"}).Inc()
+					continue
+				}
+				ch <- metric
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// collectBackupEntryMetrics collects BackupEntry metrics.
+func (c gardenMetricsCollector) collectBackupEntryMetrics(ch chan<- prometheus.Metric) {
+	backupEntries, err := c.backupEntryInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "backupentries"}).Inc()
+		return
+	}
+	projects, err := c.projectInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "projects-count"}).Inc()
+		return
+	}
+
+	for _, backupEntry := range backupEntries {
+		projectName, err := findProject(projects, backupEntry.Namespace)
+		if err != nil {
+			c.logger.Error(err.Error())
+			continue
+		}
+
+		var (
+			seed  = unknown
+			shoot = unknown
+		)
+		if backupEntry.Spec.SeedName != nil {
+			seed = *backupEntry.Spec.SeedName
+		}
+		// The BackupEntry is owned by its Shoot as long as the Shoot exists.
+		for _, ownerReference := range backupEntry.OwnerReferences {
+			if ownerReference.Kind == "Shoot" {
+				shoot = ownerReference.Name
+				break
+			}
+		}
+
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenBackupEntryInfo], prometheus.GaugeValue, 0, backupEntry.Name, *projectName, shoot, seed, backupEntry.Spec.BucketName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "backupentries"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		if lastOperation := backupEntry.Status.LastOperation; lastOperation != nil {
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenBackupEntryOperationState], prometheus.GaugeValue, mapOperationState(lastOperation.State), backupEntry.Name, *projectName, string(lastOperation.Type))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "backupentries"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Expose the deletion timestamp to detect BackupEntries which are stuck in deletion.
+		if backupEntry.DeletionTimestamp != nil {
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenBackupEntryDeletion], prometheus.GaugeValue, float64(backupEntry.DeletionTimestamp.Unix()), backupEntry.Name, *projectName)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "backupentries"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+	}
+}
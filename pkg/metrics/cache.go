@@ -0,0 +1,82 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// metricsCache holds the metrics of the last collection run. It is used when
+// the metrics are recomputed in the background instead of on each scrape.
+type metricsCache struct {
+	sync.RWMutex
+	metrics []prometheus.Metric
+}
+
+func (m *metricsCache) get() []prometheus.Metric {
+	m.RLock()
+	defer m.RUnlock()
+	return m.metrics
+}
+
+func (m *metricsCache) set(metrics []prometheus.Metric) {
+	m.Lock()
+	defer m.Unlock()
+	m.metrics = metrics
+}
+
+// runCollectLoop recomputes the metrics cache in the configured collect interval
+// until the passed context is cancelled.
+func (c *gardenMetricsCollector) runCollectLoop(ctx context.Context) {
+	c.refreshCache()
+
+	ticker := time.NewTicker(c.collectInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.refreshCache()
+		}
+	}
+}
+
+// refreshCache runs all collectors and replaces the content of the metrics cache.
+func (c *gardenMetricsCollector) refreshCache() {
+	var (
+		metrics []prometheus.Metric
+
+		ch   = make(chan prometheus.Metric)
+		done = make(chan struct{})
+	)
+
+	go func() {
+		for metric := range ch {
+			metrics = append(metrics, metric)
+		}
+		close(done)
+	}()
+
+	c.collect(ch)
+	close(ch)
+	<-done
+
+	c.cache.set(metrics)
+}
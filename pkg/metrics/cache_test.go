@@ -0,0 +1,100 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCollectFromCache(t *testing.T) {
+	c := newTestCollector(t, newTestProject("dev", "garden-dev"))
+	c.collectInterval = time.Minute
+
+	// The cache is empty until it is refreshed the first time.
+	if got := collectValues(c); len(got) != 0 {
+		t.Fatalf("got %d series before the initial refresh, want 0", len(got))
+	}
+
+	c.refreshCache()
+	series := collectSeries(c.Collect)
+	if _, ok := series[c.descs[metricGardenProjectsStatus]]; !ok {
+		t.Fatalf("project metrics are not served from the cache")
+	}
+
+	// Changes are only visible after the next refresh.
+	if err := c.projectInformer.Informer().GetIndexer().Add(newTestProject("prod", "garden-prod")); err != nil {
+		t.Fatalf("cannot add project: %s", err.Error())
+	}
+	if got := len(collectSeries(c.Collect)[c.descs[metricGardenProjectsStatus]]); got != 1 {
+		t.Errorf("got %d project series before the refresh, want 1", got)
+	}
+	c.refreshCache()
+	if got := len(collectSeries(c.Collect)[c.descs[metricGardenProjectsStatus]]); got != 2 {
+		t.Errorf("got %d project series after the refresh, want 2", got)
+	}
+}
+
+func TestCollectWithoutCache(t *testing.T) {
+	c := newTestCollector(t, newTestProject("dev", "garden-dev"))
+
+	// Without collect interval, the metrics are computed on each scrape.
+	if got := len(collectSeries(c.Collect)[c.descs[metricGardenProjectsStatus]]); got != 1 {
+		t.Errorf("got %d project series, want 1", got)
+	}
+	if got := len(c.cache.get()); got != 0 {
+		t.Errorf("got %d cached metrics, want 0", got)
+	}
+}
+
+func TestRunCollectLoop(t *testing.T) {
+	c := newTestCollector(t, newTestProject("dev", "garden-dev"))
+	c.collectInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.runCollectLoop(ctx)
+		close(done)
+	}()
+
+	// The cache is refreshed directly when the loop starts and then in the collect interval.
+	deadline := time.After(5 * time.Second)
+	for len(c.cache.get()) == 0 {
+		select {
+		case <-deadline:
+			t.Fatalf("cache was not refreshed")
+		case <-time.After(time.Millisecond):
+		}
+	}
+	if err := c.projectInformer.Informer().GetIndexer().Add(newTestProject("prod", "garden-prod")); err != nil {
+		t.Fatalf("cannot add project: %s", err.Error())
+	}
+	for len(collectSeries(c.Collect)[c.descs[metricGardenProjectsStatus]]) != 2 {
+		select {
+		case <-deadline:
+			t.Fatalf("cache was not refreshed in the collect interval")
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("collect loop did not stop after the context was cancelled")
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strconv"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// collectCloudProfileMetrics collects CloudProfile metrics.
+func (c gardenMetricsCollector) collectCloudProfileMetrics(ch chan<- prometheus.Metric) {
+	cloudProfiles, err := c.cloudProfileInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "cloudprofiles"}).Inc()
+		return
+	}
+
+	for _, cloudProfile := range cloudProfiles {
+		// Expose the regions and zones offered by the CloudProfile.
+		// Regions without zones are exposed with an empty zone label.
+		for _, region := range cloudProfile.Spec.Regions {
+			zones := []string{""}
+			if len(region.Zones) > 0 {
+				zones = zones[:0]
+				for _, zone := range region.Zones {
+					zones = append(zones, zone.Name)
+				}
+			}
+			for _, zone := range zones {
+				metric, err := prometheus.NewConstMetric(c.descs[metricGardenCloudProfileRegionInfo], prometheus.GaugeValue, 0, cloudProfile.Name, region.Name, zone)
+				if err != nil {
+					ScrapeFailures.With(prometheus.Labels{"kind": "cloudprofiles"}).Inc()
+					continue
+				}
+				ch <- metric
+			}
+		}
+
+		// Expose the offered Kubernetes versions and when they expire.
+		c.collectExpirableVersionMetrics(cloudProfile.Spec.Kubernetes.Versions, metricGardenCloudProfileKubernetesVersion, metricGardenCloudProfileKubernetesExpiration, []string{cloudProfile.Name}, ch)
+
+		// Expose the offered machine image versions and when they expire.
+		for _, machineImage := range cloudProfile.Spec.MachineImages {
+			c.collectExpirableVersionMetrics(machineImage.Versions, metricGardenCloudProfileImageVersion, metricGardenCloudProfileImageExpiration, []string{cloudProfile.Name, machineImage.Name}, ch)
+		}
+
+		// Expose the offered machine types and their capacity. Machine types are usable unless stated otherwise.
+		for _, machineType := range cloudProfile.Spec.MachineTypes {
+			usable := machineType.Usable == nil || *machineType.Usable
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenCloudProfileMachineType], prometheus.GaugeValue, 0, cloudProfile.Name, machineType.Name, strconv.FormatBool(usable))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "cloudprofiles"}).Inc()
+				continue
+			}
+			ch <- metric
+
+			for resourceName, quantity := range map[string]resource.Quantity{
+				"cpu":    machineType.CPU,
+				"memory": machineType.Memory,
+				"gpu":    machineType.GPU,
+			} {
+				metric, err := prometheus.NewConstMetric(c.descs[metricGardenCloudProfileMachineResource], prometheus.GaugeValue, float64(quantity.MilliValue())/1000, cloudProfile.Name, machineType.Name, resourceName)
+				if err != nil {
+					ScrapeFailures.With(prometheus.Labels{"kind": "cloudprofiles"}).Inc()
+					continue
+				}
+				ch <- metric
+			}
+		}
+	}
+}
+
+// collectExpirableVersionMetrics exposes an info metric with the classification of each version and the expiration
+// timestamp of the versions which have an expiration date. The version and classification are appended to the labels.
+func (c gardenMetricsCollector) collectExpirableVersionMetrics(versions []gardenv1beta1.ExpirableVersion, infoMetric, expirationMetric string, labels []string, ch chan<- prometheus.Metric) {
+	for _, version := range versions {
+		classification := unknown
+		if version.Classification != nil {
+			classification = string(*version.Classification)
+		}
+		versionLabels := append(append([]string{}, labels...), version.Version, classification)
+
+		metric, err := prometheus.NewConstMetric(c.descs[infoMetric], prometheus.GaugeValue, 0, versionLabels...)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "cloudprofiles"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		if version.ExpirationDate == nil {
+			continue
+		}
+		metric, err = prometheus.NewConstMetric(c.descs[expirationMetric], prometheus.GaugeValue, float64(version.ExpirationDate.Unix()), versionLabels...)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "cloudprofiles"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
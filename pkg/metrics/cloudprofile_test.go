@@ -0,0 +1,40 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+func TestCollectCloudProfileRegionMetrics(t *testing.T) {
+	cloudProfile := &gardenv1beta1.CloudProfile{}
+	cloudProfile.Name = "aws"
+	cloudProfile.Spec.Regions = []gardenv1beta1.Region{
+		{Name: "eu-west-1", Zones: []gardenv1beta1.AvailabilityZone{{Name: "eu-west-1a"}, {Name: "eu-west-1b"}}},
+		{Name: "us-east-1"},
+	}
+
+	c := newTestCollector(t, cloudProfile)
+	collected := collectSeries(c.collectCloudProfileMetrics)
+
+	// Regions without zones are exposed with an empty zone label.
+	assertValues(t, collected[c.descs[metricGardenCloudProfileRegionInfo]], map[string]float64{
+		"profile=aws,region=eu-west-1,zone=eu-west-1a": 0,
+		"profile=aws,region=eu-west-1,zone=eu-west-1b": 0,
+		"profile=aws,region=us-east-1,zone=":           0,
+	})
+}
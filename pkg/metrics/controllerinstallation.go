@@ -0,0 +1,95 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// collectControllerInstallationMetrics collects ControllerInstallation metrics.
+func (c gardenMetricsCollector) collectControllerInstallationMetrics(ch chan<- prometheus.Metric) {
+	controllerInstallations, err := c.controllerInstallationInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "controllerinstallations"}).Inc()
+		return
+	}
+	seeds, err := c.seedInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "seeds"}).Inc()
+		return
+	}
+
+	// Count the unhealthy extensions per Seed. Every known Seed starts with
+	// a count of zero to expose also the Seeds without unhealthy extensions.
+	unhealthyExtensions := make(map[string]float64, len(seeds))
+	for _, seed := range seeds {
+		unhealthyExtensions[seed.Name] = 0
+	}
+	for _, controllerInstallation := range controllerInstallations {
+		healthy := false
+		for _, condition := range controllerInstallation.Status.Conditions {
+			if condition.Type == gardenv1beta1.ControllerInstallationHealthy {
+				healthy = condition.Status == gardenv1beta1.ConditionTrue
+			}
+
+			// Export a metric for each condition of the ControllerInstallation.
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenControllerInstallationCondition], prometheus.GaugeValue, mapConditionStatus(condition.Status), controllerInstallation.Name, controllerInstallation.Spec.RegistrationRef.Name, controllerInstallation.Spec.SeedRef.Name, string(condition.Type))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "controllerinstallations"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+		if !healthy {
+			unhealthyExtensions[controllerInstallation.Spec.SeedRef.Name]++
+		}
+	}
+
+	for seed, count := range unhealthyExtensions {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenSeedUnhealthyExtensions], prometheus.GaugeValue, count, seed)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "controllerinstallations"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+
+	c.collectExtensionVersionMetrics(controllerInstallations, ch)
+}
+
+// collectExtensionVersionMetrics exposes if the ControllerInstallations are lagging behind their ControllerRegistrations.
+// An installation is up-to-date if it refers to the current resource version of its registration.
+func (c gardenMetricsCollector) collectExtensionVersionMetrics(controllerInstallations []*gardenv1beta1.ControllerInstallation, ch chan<- prometheus.Metric) {
+	for _, controllerInstallation := range controllerInstallations {
+		controllerRegistration, err := c.controllerRegistrationInformer.Lister().Get(controllerInstallation.Spec.RegistrationRef.Name)
+		if err != nil {
+			continue
+		}
+
+		var mismatch float64 = 1
+		if controllerInstallation.Spec.RegistrationRef.ResourceVersion == controllerRegistration.ResourceVersion {
+			mismatch = 0
+		}
+
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenExtensionVersionMismatch], prometheus.GaugeValue, mismatch, controllerRegistration.Name, controllerInstallation.Spec.SeedRef.Name)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "controllerinstallations"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
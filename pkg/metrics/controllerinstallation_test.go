@@ -0,0 +1,85 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func newTestControllerInstallation(name, seed string, healthy *gardenv1beta1.ConditionStatus) *gardenv1beta1.ControllerInstallation {
+	controllerInstallation := &gardenv1beta1.ControllerInstallation{}
+	controllerInstallation.Name = name
+	controllerInstallation.Spec.RegistrationRef.Name = "provider-aws"
+	controllerInstallation.Spec.SeedRef.Name = seed
+	if healthy != nil {
+		controllerInstallation.Status.Conditions = []gardenv1beta1.Condition{{Type: gardenv1beta1.ControllerInstallationHealthy, Status: *healthy}}
+	}
+	return controllerInstallation
+}
+
+func TestCollectSeedUnhealthyExtensionsMetrics(t *testing.T) {
+	var (
+		healthy   = gardenv1beta1.ConditionTrue
+		unhealthy = gardenv1beta1.ConditionFalse
+		seeds     = make([]*gardenv1beta1.Seed, 3)
+	)
+	for i, name := range []string{"aws-eu1", "aws-eu2", "aws-eu3"} {
+		seeds[i] = &gardenv1beta1.Seed{}
+		seeds[i].Name = name
+	}
+
+	c := newTestCollector(t,
+		seeds[0], seeds[1], seeds[2],
+		newTestControllerInstallation("aws-eu1-healthy", "aws-eu1", &healthy),
+		newTestControllerInstallation("aws-eu2-healthy", "aws-eu2", &healthy),
+		newTestControllerInstallation("aws-eu2-unhealthy", "aws-eu2", &unhealthy),
+		// Extensions without health condition are considered as unhealthy.
+		newTestControllerInstallation("aws-eu3-unknown", "aws-eu3", nil),
+	)
+
+	collected := collectSeries(c.collectControllerInstallationMetrics)
+	assertValues(t, collected[c.descs[metricGardenSeedUnhealthyExtensions]], map[string]float64{
+		"seed=aws-eu1": 0,
+		"seed=aws-eu2": 1,
+		"seed=aws-eu3": 1,
+	})
+}
+
+func TestCollectExtensionVersionMetrics(t *testing.T) {
+	var (
+		controllerRegistration = &gardenv1beta1.ControllerRegistration{}
+		lagging                = newTestControllerInstallation("aws-eu1-provider-aws", "aws-eu1", nil)
+		current                = newTestControllerInstallation("aws-eu2-provider-aws", "aws-eu2", nil)
+	)
+	controllerRegistration.Name = "provider-aws"
+	controllerRegistration.ResourceVersion = "2"
+	lagging.Spec.RegistrationRef.ResourceVersion = "1"
+	current.Spec.RegistrationRef.ResourceVersion = "2"
+	// The installation state of the extension does not affect the version comparison.
+	lagging.Status.Conditions = []gardenv1beta1.Condition{{Type: gardenv1beta1.ControllerInstallationInstalled, Status: gardenv1beta1.ConditionTrue}}
+	current.Status.Conditions = []gardenv1beta1.Condition{{Type: gardenv1beta1.ControllerInstallationInstalled, Status: gardenv1beta1.ConditionFalse}}
+
+	c := newTestCollector(t, controllerRegistration)
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		c.collectExtensionVersionMetrics([]*gardenv1beta1.ControllerInstallation{lagging, current}, ch)
+	})
+	assertValues(t, collected[c.descs[metricGardenExtensionVersionMismatch]], map[string]float64{
+		"registration=provider-aws,seed=aws-eu1": 1,
+		"registration=provider-aws,seed=aws-eu2": 0,
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// collectControllerRegistrationMetrics collects ControllerRegistration metrics.
+func (c gardenMetricsCollector) collectControllerRegistrationMetrics(ch chan<- prometheus.Metric) {
+	controllerRegistrations, err := c.controllerRegistrationInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "controllerregistrations"}).Inc()
+		return
+	}
+
+	for _, controllerRegistration := range controllerRegistrations {
+		deploymentType := unknown
+		if controllerRegistration.Spec.Deployment != nil {
+			deploymentType = controllerRegistration.Spec.Deployment.Type
+		}
+
+		// Export a metric for each resource kind and type the controller is responsible for.
+		for _, resource := range controllerRegistration.Spec.Resources {
+			globallyEnabled := resource.GloballyEnabled != nil && *resource.GloballyEnabled
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenControllerRegistrationInfo], prometheus.GaugeValue, 0, controllerRegistration.Name, resource.Kind, resource.Type, strconv.FormatBool(globallyEnabled), deploymentType)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "controllerregistrations"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+	}
+}
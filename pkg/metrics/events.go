@@ -0,0 +1,191 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	shootsCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "garden_shoots_created_total",
+		Help: "Total count of created Shoots since the start of the exporter.",
+	})
+
+	shootsDeleted = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "garden_shoots_deleted_total",
+		Help: "Total count of deleted Shoots since the start of the exporter.",
+	})
+
+	shootCreations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_shoot_creations_total",
+		Help: "Total count of created Shoots per project, infrastructure and region since the start of the exporter.",
+	}, []string{"project", "iaas", "region"})
+
+	shootDeletions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_shoot_deletions_total",
+		Help: "Total count of deleted Shoots per project, infrastructure and region since the start of the exporter.",
+	}, []string{"project", "iaas", "region"})
+
+	shootCreationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_shoot_creation_duration_seconds",
+		Help:    "Duration from the creation of a Shoot until its create operation succeeded.",
+		Buckets: []float64{60, 120, 180, 300, 450, 600, 900, 1200, 1800, 3600},
+	}, []string{"iaas"})
+
+	shootHibernationTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_shoot_hibernation_transitions_total",
+		Help: "Total count of transitions of a Shoot into or out of hibernation since the start of the exporter.",
+	}, []string{"name", "project", "transition"})
+
+	shootConditionTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_shoot_condition_transitions_total",
+		Help: "Total count of status transitions of a condition of a Shoot since the start of the exporter.",
+	}, []string{"name", "project", "condition"})
+
+	shootOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_shoot_operation_duration_seconds",
+		Help:    "Duration of the succeeded operations of the Shoots, observed since the start of the exporter.",
+		Buckets: []float64{30, 60, 120, 300, 600, 900, 1200, 1800, 3600, 7200},
+	}, []string{"operation", "seed", "iaas"})
+)
+
+// Transitions of the hibernation status of a Shoot.
+const (
+	hibernationTransitionHibernate = "hibernate"
+	hibernationTransitionWakeUp    = "wake_up"
+)
+
+// registerShootEventHandlers registers handlers on the Shoot informer to observe
+// metrics which can only be derived from changes of the Shoot resources. Like
+// the collected metrics, only Shoots of the own shard are observed.
+func (c *gardenMetricsCollector) registerShootEventHandlers(startTime time.Time) {
+	c.shootInformer.Informer().AddEventHandler(c.shootEventHandlers(startTime))
+}
+
+// shootEventHandlers returns the handlers which observe the Shoot changes. Shoots
+// which were created before the passed start time are not counted as created.
+func (c *gardenMetricsCollector) shootEventHandlers(startTime time.Time) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			shoot, ok := obj.(*gardenv1beta1.Shoot)
+			if !ok || !c.inShard(shoot) {
+				return
+			}
+			// The informer notifies about all existing Shoots when the handler is
+			// registered. Count only Shoots which were created after the exporter started.
+			if shoot.CreationTimestamp.Time.Before(startTime) {
+				return
+			}
+			shootsCreated.Inc()
+			shootCreations.WithLabelValues(c.shootProjectName(shoot), shoot.Spec.Provider.Type, shoot.Spec.Region).Inc()
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldShoot, ok := oldObj.(*gardenv1beta1.Shoot)
+			if !ok {
+				return
+			}
+			newShoot, ok := newObj.(*gardenv1beta1.Shoot)
+			if !ok || !c.inShard(newShoot) {
+				return
+			}
+			// Observe the creation duration once the create operation switches to succeeded.
+			if createSucceeded(newShoot) && !createSucceeded(oldShoot) {
+				duration := newShoot.Status.LastOperation.LastUpdateTime.Sub(newShoot.CreationTimestamp.Time)
+				shootCreationDuration.With(prometheus.Labels{"iaas": newShoot.Spec.Provider.Type}).Observe(duration.Seconds())
+			}
+
+			// Observe the duration of operations which have succeeded.
+			if operationType, duration, ok := c.operationTracker.observe(oldShoot, newShoot); ok {
+				shootOperationDuration.WithLabelValues(string(operationType), shootSeedName(newShoot), newShoot.Spec.Provider.Type).Observe(duration.Seconds())
+			}
+
+			// Count the status transitions of the conditions of the Shoot, to detect flapping conditions.
+			oldStatuses := make(map[gardenv1beta1.ConditionType]gardenv1beta1.ConditionStatus, len(oldShoot.Status.Conditions))
+			for _, condition := range oldShoot.Status.Conditions {
+				oldStatuses[condition.Type] = condition.Status
+			}
+			for _, condition := range newShoot.Status.Conditions {
+				if oldStatus, ok := oldStatuses[condition.Type]; ok && oldStatus != condition.Status {
+					c.seriesTracker.inc(newShoot.UID, shootConditionTransitions, newShoot.Name, c.shootProjectName(newShoot), string(condition.Type))
+				}
+			}
+
+			// Count the transitions of the Shoot into and out of hibernation.
+			if newShoot.Status.IsHibernated != oldShoot.Status.IsHibernated {
+				transition := hibernationTransitionWakeUp
+				if newShoot.Status.IsHibernated {
+					transition = hibernationTransitionHibernate
+				}
+				c.seriesTracker.inc(newShoot.UID, shootHibernationTransitions, newShoot.Name, c.shootProjectName(newShoot), transition)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			// The object can also be a tombstone when the deletion was missed by the informer.
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			shoot, ok := obj.(*gardenv1beta1.Shoot)
+			if !ok || !c.inShard(shoot) {
+				return
+			}
+			shootsDeleted.Inc()
+			shootDeletions.WithLabelValues(c.shootProjectName(shoot), shoot.Spec.Provider.Type, shoot.Spec.Region).Inc()
+
+			// Reset the health state of the Shoot.
+			c.healthTracker.forget(shoot.Namespace, shoot.Name)
+
+			// The Shoot is gone once its delete operation has succeeded.
+			if start, ok := c.operationTracker.forget(shoot.UID); ok && start.operationType == gardenv1beta1.LastOperationTypeDelete {
+				shootOperationDuration.WithLabelValues(string(start.operationType), shootSeedName(shoot), shoot.Spec.Provider.Type).Observe(time.Since(start.time).Seconds())
+			}
+
+			// Drop the per Shoot series, to not expose them forever.
+			c.seriesTracker.forget(shoot.UID)
+		},
+	}
+}
+
+// shootProjectName returns the name of the project of the Shoot or unknown if it cannot be determined.
+func (c *gardenMetricsCollector) shootProjectName(shoot *gardenv1beta1.Shoot) string {
+	projects, err := c.projectInformer.Lister().List(labels.Everything())
+	if err != nil {
+		return unknown
+	}
+	projectName, err := findProject(projects, shoot.Namespace)
+	if err != nil {
+		return unknown
+	}
+	return *projectName
+}
+
+// shootSeedName returns the name of the Seed the Shoot is scheduled to or unknown if it is not scheduled.
+func shootSeedName(shoot *gardenv1beta1.Shoot) string {
+	if shoot.Spec.SeedName == nil {
+		return unknown
+	}
+	return *shoot.Spec.SeedName
+}
+
+// createSucceeded checks if the last operation of the Shoot is a succeeded create operation.
+func createSucceeded(shoot *gardenv1beta1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil && lastOperation.Type == gardenv1beta1.LastOperationTypeCreate && lastOperation.State == gardenv1beta1.LastOperationStateSucceeded
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestShootEventHandlersCreatedAndDeleted(t *testing.T) {
+	shootCreations.Reset()
+	shootDeletions.Reset()
+
+	// The unlabeled counters cannot be reset, hence only their increase is checked.
+	var (
+		createdBefore = collectValues(shootsCreated)[""]
+		deletedBefore = collectValues(shootsDeleted)[""]
+		startTime     = time.Now()
+		existing      = newTestShoot("garden-dev", "existing", "uid-1")
+		created       = newTestShoot("garden-dev", "created", "uid-2")
+		orphaned      = newTestShoot("garden-orphaned", "orphaned", "uid-3")
+		c             = newTestCollector(t, newTestProject("dev", "garden-dev"))
+		handlers      = c.shootEventHandlers(startTime)
+	)
+	existing.CreationTimestamp = metav1.NewTime(startTime.Add(-time.Hour))
+	created.CreationTimestamp = metav1.NewTime(startTime.Add(time.Second))
+	orphaned.CreationTimestamp = metav1.NewTime(startTime.Add(time.Second))
+
+	// Shoots which existed before the start are listed as added when the informer starts.
+	handlers.OnAdd(existing)
+	handlers.OnAdd(created)
+	handlers.OnAdd(orphaned)
+	// Objects of other types are ignored.
+	handlers.OnAdd(newTestProject("prod", "garden-prod"))
+
+	assertValues(t, collectValues(shootsCreated), map[string]float64{"": createdBefore + 2})
+	assertValues(t, collectValues(shootCreations), map[string]float64{
+		"iaas=aws,project=dev,region=eu-west-1":     1,
+		"iaas=aws,project=unknown,region=eu-west-1": 1,
+	})
+
+	handlers.OnDelete(existing)
+	// The informer passes a tombstone if it missed the deletion.
+	handlers.OnDelete(cache.DeletedFinalStateUnknown{Key: "garden-dev/created", Obj: created})
+	handlers.OnDelete(cache.DeletedFinalStateUnknown{Key: "garden-dev/unknown", Obj: nil})
+
+	assertValues(t, collectValues(shootsDeleted), map[string]float64{"": deletedBefore + 2})
+	assertValues(t, collectValues(shootDeletions), map[string]float64{
+		"iaas=aws,project=dev,region=eu-west-1": 2,
+	})
+}
+
+func TestShootEventHandlersShard(t *testing.T) {
+	shootCreations.Reset()
+	shootDeletions.Reset()
+
+	var (
+		startTime     = time.Now()
+		shoot         = newTestShoot("garden-dev", "foo", "uid-1")
+		createdBefore = collectValues(shootsCreated)[""]
+		deletedBefore = collectValues(shootsDeleted)[""]
+	)
+	shoot.CreationTimestamp = metav1.NewTime(startTime.Add(time.Second))
+
+	// The Shoot is counted by exactly one of the shards.
+	for index := uint32(0); index < 3; index++ {
+		c := newTestCollector(t, newTestProject("dev", "garden-dev"))
+		c.shardIndex, c.shardTotal = index, 3
+		handlers := c.shootEventHandlers(startTime)
+		handlers.OnAdd(shoot)
+		handlers.OnDelete(cache.DeletedFinalStateUnknown{Key: "garden-dev/foo", Obj: shoot})
+	}
+
+	assertValues(t, collectValues(shootsCreated), map[string]float64{"": createdBefore + 1})
+	assertValues(t, collectValues(shootsDeleted), map[string]float64{"": deletedBefore + 1})
+	assertValues(t, collectValues(shootCreations), map[string]float64{
+		"iaas=aws,project=dev,region=eu-west-1": 1,
+	})
+	assertValues(t, collectValues(shootDeletions), map[string]float64{
+		"iaas=aws,project=dev,region=eu-west-1": 1,
+	})
+}
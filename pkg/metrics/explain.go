@@ -0,0 +1,130 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// metricExplanation describes the value of a metric which is exposed for a Shoot
+// together with the information of the Shoot which led to the value. The labels
+// contain the labels which identify the series of the Shoot.
+type metricExplanation struct {
+	Metric string            `json:"metric"`
+	Labels map[string]string `json:"labels"`
+	Value  float64           `json:"value"`
+	Reason string            `json:"reason"`
+}
+
+// ExplainHandler serves explanations for the metric values of a single Shoot.
+// The values are computed with the same function which is used by the collector.
+type ExplainHandler struct {
+	collector *gardenMetricsCollector
+}
+
+// ServeHTTP implements the http.Handler interface. The Shoot is passed via the
+// query parameter shoot in the format <namespace>/<name>.
+func (h *ExplainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(r.URL.Query().Get("shoot"))
+	if err != nil || namespace == "" || name == "" {
+		http.Error(w, "query parameter shoot must be in the format <namespace>/<name>", http.StatusBadRequest)
+		return
+	}
+
+	shoot, err := h.collector.shootInformer.Lister().Shoots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		http.Error(w, fmt.Sprintf("shoot %s/%s not found", namespace, name), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if reason := h.collector.explainSkipReason(shoot, time.Now()); reason != "" {
+		http.Error(w, fmt.Sprintf("metrics of shoot %s/%s are not exposed, it %s", namespace, name, reason), http.StatusNotFound)
+		return
+	}
+
+	explanations, err := h.collector.explainShoot(shoot)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(explanations); err != nil {
+		h.collector.logger.Errorf("Could not write explanation for shoot %s/%s. %s", namespace, name, err.Error())
+	}
+}
+
+// explainSkipReason returns why the collector does not expose the explained metrics of the Shoot,
+// or an empty string if they are exposed.
+func (c gardenMetricsCollector) explainSkipReason(shoot *gardenv1beta1.Shoot, now time.Time) string {
+	switch {
+	case !c.inShard(shoot):
+		return "is exposed by another shard"
+	case shoot.Spec.SeedName == nil:
+		return "is not scheduled to a seed yet"
+	case !c.changedRecently(shoot, now):
+		return "has not changed recently"
+	}
+	return ""
+}
+
+// explainShoot explains the values of the hibernation, operation state and condition metrics of the Shoot.
+// The labels are taken from the metrics, hence they match the series exposed by the collector.
+func (c gardenMetricsCollector) explainShoot(shoot *gardenv1beta1.Shoot) ([]metricExplanation, error) {
+	projects, err := c.projectInformer.Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	projectName, err := findProject(projects, shoot.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var explanations []metricExplanation
+	for _, value := range shootStatusValues(shoot, *projectName) {
+		metric, err := prometheus.NewConstMetric(c.descs[value.metric], prometheus.GaugeValue, value.value, value.labels...)
+		if err != nil {
+			return nil, err
+		}
+		m := &dto.Metric{}
+		if err := metric.Write(m); err != nil {
+			return nil, err
+		}
+		metricLabels := make(map[string]string, len(m.Label))
+		for _, label := range m.Label {
+			metricLabels[label.GetName()] = label.GetValue()
+		}
+		explanations = append(explanations, metricExplanation{
+			Metric: value.metric,
+			Labels: metricLabels,
+			Value:  value.value,
+			Reason: value.reason,
+		})
+	}
+	return explanations, nil
+}
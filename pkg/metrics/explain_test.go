@@ -0,0 +1,101 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExplainShootMatchesCollectedSeries(t *testing.T) {
+	var (
+		shoot = newTestShoot("garden-dev", "foo", "uid-1")
+		c     = newTestCollector(t, newTestProject("dev", "garden-dev"), shoot)
+	)
+
+	explanations, err := c.explainShoot(shoot)
+	if err != nil {
+		t.Fatalf("cannot explain Shoot: %s", err.Error())
+	}
+	// One hibernation, three operation state and two condition series.
+	if len(explanations) != 6 {
+		t.Fatalf("got %d explanations, want 6", len(explanations))
+	}
+
+	collected := collectSeries(c.collectShootMetrics)
+	for _, explanation := range explanations {
+		pairs := make([]string, 0, len(explanation.Labels))
+		for name, value := range explanation.Labels {
+			pairs = append(pairs, name+"="+value)
+		}
+		sort.Strings(pairs)
+		key := strings.Join(pairs, ",")
+
+		value, ok := collected[c.descs[explanation.Metric]][key]
+		if !ok {
+			t.Errorf("explained series %s{%s} is not collected", explanation.Metric, key)
+			continue
+		}
+		if value != explanation.Value {
+			t.Errorf("explained series %s{%s}: got %v, collected %v", explanation.Metric, key, explanation.Value, value)
+		}
+	}
+}
+
+func TestExplainHandler(t *testing.T) {
+	unscheduled := newTestShoot("garden-dev", "unscheduled", "uid-2")
+	unscheduled.Spec.SeedName = nil
+	unchanged := newTestShoot("garden-dev", "unchanged", "uid-3")
+	unchanged.Status.LastOperation.LastUpdateTime = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+
+	tests := []struct {
+		name        string
+		query       string
+		shardTotal  uint32
+		wantCode    int
+		wantMessage string
+	}{
+		{name: "invalid query", query: "foo", wantCode: http.StatusBadRequest},
+		{name: "unknown shoot", query: "garden-dev/bar", wantCode: http.StatusNotFound},
+		{name: "exposed shoot", query: "garden-dev/foo", wantCode: http.StatusOK},
+		{name: "unscheduled shoot", query: "garden-dev/unscheduled", wantCode: http.StatusNotFound, wantMessage: "not scheduled"},
+		{name: "unchanged shoot", query: "garden-dev/unchanged", wantCode: http.StatusNotFound, wantMessage: "not changed recently"},
+		{name: "shoot of another shard", query: "garden-dev/foo", shardTotal: 2, wantCode: http.StatusNotFound, wantMessage: "another shard"},
+	}
+	for _, tt := range tests {
+		c := newTestCollector(t, newTestProject("dev", "garden-dev"), newTestShoot("garden-dev", "foo", "uid-1"), unscheduled, unchanged)
+		c.onlyRecentlyChanged = time.Hour
+		c.shardTotal = tt.shardTotal
+		// Use the shard which does not contain the Shoot.
+		if tt.shardTotal > 1 && c.inShard(newTestShoot("garden-dev", "foo", "uid-1")) {
+			c.shardIndex = 1
+		}
+
+		recorder := httptest.NewRecorder()
+		(&ExplainHandler{collector: c}).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/explain?shoot="+tt.query, nil))
+		if recorder.Code != tt.wantCode {
+			t.Errorf("%s: got status %d, want %d", tt.name, recorder.Code, tt.wantCode)
+		}
+		if !strings.Contains(recorder.Body.String(), tt.wantMessage) {
+			t.Errorf("%s: body %q does not contain %q", tt.name, recorder.Body.String(), tt.wantMessage)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// shootHealthTracker remembers the last time each Shoot was observed as healthy.
+// The state is kept across scrapes and is only reset when a Shoot gets deleted.
+type shootHealthTracker struct {
+	sync.Mutex
+	lastHealthy map[string]time.Time
+}
+
+func newShootHealthTracker() *shootHealthTracker {
+	return &shootHealthTracker{lastHealthy: make(map[string]time.Time)}
+}
+
+// observe records the passed time for the Shoot if all of its conditions are healthy
+// and returns the last time the Shoot was observed as healthy, if there was any.
+func (t *shootHealthTracker) observe(shoot *gardenv1beta1.Shoot, now time.Time) (time.Time, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	key := shootKey(shoot.Namespace, shoot.Name)
+	if conditionsHealthy(shoot.Status.Conditions) {
+		t.lastHealthy[key] = now
+	}
+	lastHealthy, ok := t.lastHealthy[key]
+	return lastHealthy, ok
+}
+
+// forget removes the state of the Shoot.
+func (t *shootHealthTracker) forget(namespace, name string) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.lastHealthy, shootKey(namespace, name))
+}
+
+// conditionsHealthy checks if there are conditions and all of them are True.
+func conditionsHealthy(conditions []gardenv1beta1.Condition) bool {
+	if len(conditions) == 0 {
+		return false
+	}
+	for _, condition := range conditions {
+		if condition.Status != gardenv1beta1.ConditionTrue {
+			return false
+		}
+	}
+	return true
+}
+
+// healthScore computes the weighted share of the conditions and constraints of the Shoot which are True.
+// Types without a configured weight have the weight 1. It returns false if the Shoot has neither
+// conditions nor constraints or all of them have the weight 0.
+func healthScore(shoot *gardenv1beta1.Shoot, weights map[string]int) (float64, bool) {
+	var score, total float64
+	for _, conditions := range [][]gardenv1beta1.Condition{shoot.Status.Conditions, shoot.Status.Constraints} {
+		for _, condition := range conditions {
+			weight := 1
+			if w, ok := weights[string(condition.Type)]; ok {
+				weight = w
+			}
+			total += float64(weight)
+			if condition.Status == gardenv1beta1.ConditionTrue {
+				score += float64(weight)
+			}
+		}
+	}
+	if total == 0 {
+		return 0, false
+	}
+	return score / total, true
+}
+
+func shootKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
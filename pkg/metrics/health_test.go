@@ -0,0 +1,75 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+func TestShootHealthTracker(t *testing.T) {
+	var (
+		tracker = newShootHealthTracker()
+		shoot   = newTestShoot("garden-dev", "foo", "uid-1")
+		start   = time.Date(2020, time.June, 10, 10, 0, 0, 0, time.UTC)
+	)
+
+	// The Shoot has not been healthy yet.
+	if _, ok := tracker.observe(shoot, start); ok {
+		t.Fatalf("unhealthy Shoot is tracked as healthy")
+	}
+
+	shoot.Status.Conditions[1].Status = gardenv1beta1.ConditionTrue
+	if lastHealthy, ok := tracker.observe(shoot, start.Add(time.Minute)); !ok || !lastHealthy.Equal(start.Add(time.Minute)) {
+		t.Errorf("healthy Shoot: got %s (%t), want %s", lastHealthy, ok, start.Add(time.Minute))
+	}
+
+	// The last healthy time is kept while the Shoot is unhealthy.
+	shoot.Status.Conditions[1].Status = gardenv1beta1.ConditionProgressing
+	if lastHealthy, ok := tracker.observe(shoot, start.Add(2*time.Minute)); !ok || !lastHealthy.Equal(start.Add(time.Minute)) {
+		t.Errorf("unhealthy Shoot: got %s (%t), want %s", lastHealthy, ok, start.Add(time.Minute))
+	}
+
+	// Shoots with the same name in other namespaces are tracked separately.
+	other := newTestShoot("garden-prod", "foo", "uid-2")
+	if _, ok := tracker.observe(other, start.Add(2*time.Minute)); ok {
+		t.Errorf("Shoot of another namespace is tracked as healthy")
+	}
+
+	tracker.forget(shoot.Namespace, shoot.Name)
+	if _, ok := tracker.observe(shoot, start.Add(3*time.Minute)); ok {
+		t.Errorf("forgotten Shoot is tracked as healthy")
+	}
+}
+
+func TestConditionsHealthy(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []gardenv1beta1.Condition
+		want       bool
+	}{
+		{"no conditions", nil, false},
+		{"all true", []gardenv1beta1.Condition{{Status: gardenv1beta1.ConditionTrue}, {Status: gardenv1beta1.ConditionTrue}}, true},
+		{"one false", []gardenv1beta1.Condition{{Status: gardenv1beta1.ConditionTrue}, {Status: gardenv1beta1.ConditionFalse}}, false},
+		{"one unknown", []gardenv1beta1.Condition{{Status: gardenv1beta1.ConditionUnknown}}, false},
+	}
+	for _, tt := range tests {
+		if got := conditionsHealthy(tt.conditions); got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/tools/cache"
+)
+
+// collectInformerMetrics collects metrics about the informer caches of the exporter.
+func (c gardenMetricsCollector) collectInformerMetrics(ch chan<- prometheus.Metric) {
+	stores := map[string]cache.Store{
+		"shoots":                  c.shootInformer.Informer().GetStore(),
+		"seeds":                   c.seedInformer.Informer().GetStore(),
+		"projects":                c.projectInformer.Informer().GetStore(),
+		"plants":                  c.plantInformer.Informer().GetStore(),
+		"cloudprofiles":           c.cloudProfileInformer.Informer().GetStore(),
+		"controllerinstallations": c.controllerInstallationInformer.Informer().GetStore(),
+		"controllerregistrations": c.controllerRegistrationInformer.Informer().GetStore(),
+		"backupbuckets":           c.backupBucketInformer.Informer().GetStore(),
+		"backupentries":           c.backupEntryInformer.Informer().GetStore(),
+		"quotas":                  c.quotaInformer.Informer().GetStore(),
+		"secretbindings":          c.secretBindingInformer.Informer().GetStore(),
+		"resourcequotas":          c.resourceQuotaInformer.Informer().GetStore(),
+	}
+	if c.namespaceInformer != nil {
+		stores["namespaces"] = c.namespaceInformer.Informer().GetStore()
+	}
+
+	for resource, store := range stores {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenInformerCacheSize], prometheus.GaugeValue, float64(len(store.ListKeys())), resource)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "informers"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
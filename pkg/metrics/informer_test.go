@@ -0,0 +1,47 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	kubeinformers "k8s.io/client-go/informers"
+)
+
+func TestCollectInformerMetrics(t *testing.T) {
+	c := newTestCollector(t,
+		newTestProject("dev", "garden-dev"),
+		newTestProject("prod", "garden-prod"),
+		newTestShoot("garden-dev", "foo", "uid-1"),
+	)
+
+	collected := collectSeries(c.collectInformerMetrics)[c.descs[metricGardenInformerCacheSize]]
+	if got, want := collected["resource=projects"], 2.0; got != want {
+		t.Errorf("projects: got %v, want %v", got, want)
+	}
+	if got, want := collected["resource=shoots"], 1.0; got != want {
+		t.Errorf("shoots: got %v, want %v", got, want)
+	}
+	// Namespaces are only watched if the environment metric is enabled.
+	if _, ok := collected["resource=namespaces"]; ok {
+		t.Errorf("namespaces are exposed without namespace informer")
+	}
+
+	c.namespaceInformer = kubeinformers.NewSharedInformerFactory(nil, 0).Core().V1().Namespaces()
+	collected = collectSeries(c.collectInformerMetrics)[c.descs[metricGardenInformerCacheSize]]
+	if _, ok := collected["resource=namespaces"]; !ok {
+		t.Errorf("namespaces are not exposed with namespace informer")
+	}
+}
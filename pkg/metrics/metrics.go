@@ -15,33 +15,141 @@
 package metrics
 
 import (
+	"context"
+	"time"
+
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions/core/v1beta1"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	kubecoreinformers "k8s.io/client-go/informers/core/v1"
 )
 
 const (
-	metricGardenProjectsStatus = "garden_projects_status"
-	metricGardenUsersSum       = "garden_users_total"
+	// Exporter metric
+	metricGardenInformerCacheSize = "garden_informer_cache_size"
+
+	// ControllerInstallation metric
+	metricGardenControllerInstallationCondition = "garden_controllerinstallation_condition"
+
+	// ControllerRegistration metric
+	metricGardenControllerRegistrationInfo = "garden_controllerregistration_info"
+
+	// Extension metric
+	metricGardenExtensionVersionMismatch = "garden_extension_version_mismatch"
+
+	// BackupBucket metric
+	metricGardenBackupBucketInfo           = "garden_backupbucket_info"
+	metricGardenBackupBucketLastError      = "garden_backupbucket_last_error"
+	metricGardenBackupBucketOperationState = "garden_backupbucket_operation_state"
+
+	// BackupEntry metric
+	metricGardenBackupEntryDeletion       = "garden_backupentry_deletion_timestamp"
+	metricGardenBackupEntryInfo           = "garden_backupentry_info"
+	metricGardenBackupEntryOperationState = "garden_backupentry_operation_state"
+
+	// CloudProfile metric
+	metricGardenCloudProfileImageExpiration      = "garden_cloudprofile_machine_image_version_expiration_timestamp"
+	metricGardenCloudProfileImageVersion         = "garden_cloudprofile_machine_image_version_info"
+	metricGardenCloudProfileKubernetesExpiration = "garden_cloudprofile_kubernetes_version_expiration_timestamp"
+	metricGardenCloudProfileKubernetesVersion    = "garden_cloudprofile_kubernetes_version_info"
+	metricGardenCloudProfileMachineResource      = "garden_cloudprofile_machine_type_resource"
+	metricGardenCloudProfileMachineType          = "garden_cloudprofile_machine_type_info"
+	metricGardenCloudProfileRegionInfo           = "garden_cloudprofile_region_info"
+
+	metricGardenProjectCreation      = "garden_project_creation_timestamp"
+	metricGardenProjectMembers       = "garden_project_members_total"
+	metricGardenProjectsStatus       = "garden_projects_status"
+	metricGardenProjectShootsPurpose = "garden_project_shoots_by_purpose"
+	metricGardenProjectQuotaExceeded = "garden_project_quota_exceeded"
+	metricGardenProjectQuotaHard     = "garden_project_quota_hard"
+	metricGardenProjectQuotaUsed     = "garden_project_quota_used"
+	metricGardenUsersSum             = "garden_users_total"
+
+	// Quota metric
+	metricGardenQuotaClusterLifetime = "garden_quota_cluster_lifetime_days"
+	metricGardenQuotaInfo            = "garden_quota_info"
+	metricGardenQuotaLimit           = "garden_quota_limit"
+
+	// SecretBinding metric
+	metricGardenSecretBindingCreation = "garden_secretbinding_creation_timestamp"
+	metricGardenSecretBindingInfo     = "garden_secretbinding_info"
+	metricGardenSecretBindingQuota    = "garden_secretbinding_quota_info"
+	metricGardenSecretBindingShoots   = "garden_secretbinding_shoots_total"
 
 	// Seed metric
-	metricGardenSeedInfo      = "garden_seed_info"
-	metricGardenSeedCondition = "garden_seed_condition"
+	metricGardenSeedAcceptingShoots     = "garden_seed_accepting_shoots"
+	metricGardenSeedInfo                = "garden_seed_info"
+	metricGardenSeedUnhealthyExtensions = "garden_seed_unhealthy_extensions_count"
+	metricGardenSeedCondition           = "garden_seed_condition"
+	metricGardenSeedConditionStatus     = "garden_seed_condition_status"
+	metricGardenSeedConditionStale      = "garden_seed_condition_stale"
+	metricGardenSeedsByGardenletVersion = "garden_seeds_by_gardenlet_version"
 
 	// Plant metric
 	metricGardenPlantInfo      = "garden_plant_info"
 	metricGardenPlantCondition = "garden_plant_condition"
+	metricGardenPlantsTotal    = "garden_plants_total"
 
 	// Shoot metric (available also for Shoots which act as Seed).
+	metricGardenShootAddon                    = "garden_shoot_addon"
+	metricGardenShootAdmissionPluginInfo      = "garden_shoot_admission_plugin_info"
+	metricGardenShootAlertReceiverInfo        = "garden_shoot_alert_receiver_info"
+	metricGardenShootAuditPolicyConfigured    = "garden_shoot_audit_policy_configured"
+	metricGardenShootAutoUpdateEnabled        = "garden_shoot_auto_update_enabled"
+	metricGardenShootAutoscalerDuration       = "garden_shoot_autoscaler_duration_seconds"
+	metricGardenShootAutoscalerThreshold      = "garden_shoot_autoscaler_scale_down_utilization_threshold"
+	metricGardenShootBackupOK                 = "garden_shoot_backup_ok"
+	metricGardenShootCloudProfileInfo         = "garden_shoot_cloudprofile_info"
+	metricGardenShootClusterIdentityInfo      = "garden_shoot_cluster_identity_info"
 	metricGardenShootCondition                = "garden_shoot_condition"
+	metricGardenShootConditionCode            = "garden_shoot_condition_code"
+	metricGardenShootConditionStatus          = "garden_shoot_condition_status"
+	metricGardenShootConditionTransition      = "garden_shoot_condition_last_transition_timestamp"
+	metricGardenShootConstraint               = "garden_shoot_constraint"
 	metricGardenShootCreation                 = "garden_shoot_creation_timestamp"
+	metricGardenShootDeletion                 = "garden_shoot_deletion_timestamp"
+	metricGardenShootDeletionConfirmed        = "garden_shoot_deletion_confirmed"
+	metricGardenShootDNSInfo                  = "garden_shoot_dns_info"
+	metricGardenShootEnvironmentInfo          = "garden_shoot_environment_info"
+	metricGardenShootExtensionEnabled         = "garden_shoot_extension_enabled"
+	metricGardenShootFeatureGateEnabled       = "garden_shoot_feature_gate_enabled"
+	metricGardenShootForcedUpgradeImminent    = "garden_shoot_forced_upgrade_imminent"
+	metricGardenShootHealthScore              = "garden_shoot_health_score"
 	metricGardenShootHibernated               = "garden_shoot_hibernated"
+	metricGardenShootHibernationNextStart     = "garden_shoot_hibernation_schedule_next_start"
+	metricGardenShootHibernationNextStop      = "garden_shoot_hibernation_schedule_next_stop"
+	metricGardenShootIgnored                  = "garden_shoot_ignored"
 	metricGardenShootInfo                     = "garden_shoot_info"
+	metricGardenShootKubernetesVersion        = "garden_shoot_kubernetes_version"
+	metricGardenShootLastError                = "garden_shoot_last_error"
+	metricGardenShootLastHealthyTimestamp     = "garden_shoot_last_healthy_timestamp_seconds"
+	metricGardenShootLastOperationLastUpdate  = "garden_shoot_last_operation_last_update_timestamp"
+	metricGardenShootLastOperationSinceUpdate = "garden_shoot_last_operation_seconds_since_last_update"
+	metricGardenShootLegacyAuthEnabled        = "garden_shoot_legacy_auth_enabled"
+	metricGardenShootMaintenanceWindowInfo    = "garden_shoot_maintenance_window_info"
+	metricGardenShootMigrationInProgress      = "garden_shoot_migration_in_progress"
+	metricGardenShootNetworkingInfo           = "garden_shoot_networking_info"
+	metricGardenShootNodeCIDRMaskSize         = "garden_shoot_node_cidr_mask_size"
 	metricGardenShootNodeMaxTotal             = "garden_shoot_node_max_total"
 	metricGardenShootNodeMinTotal             = "garden_shoot_node_min_total"
+	metricGardenShootOIDCConfigured           = "garden_shoot_oidc_configured"
 	metricGardenShootOperationProgressPercent = "garden_shoot_operation_progress_percent"
 	metricGardenShootOperationState           = "garden_shoot_operation_states"
+	metricGardenShootPurpose                  = "garden_shoot_purpose"
 	metricGardenShootResponseDuration         = "garden_shoot_response_duration_milliseconds"
+	metricGardenShootServiceAccountIssuerInfo = "garden_shoot_service_account_issuer_info"
+	metricGardenShootUnscheduled              = "garden_shoot_unscheduled"
+	metricGardenShootWorkerEvictionHard       = "garden_shoot_worker_eviction_hard"
+	metricGardenShootWorkerPoolImageInfo      = "garden_shoot_worker_pool_machine_image_info"
+	metricGardenShootWorkerPoolMaxSurge       = "garden_shoot_worker_pool_max_surge"
+	metricGardenShootWorkerPoolNodeMax        = "garden_shoot_worker_pool_node_max"
+	metricGardenShootWorkerPoolNodeMin        = "garden_shoot_worker_pool_node_min"
+	metricGardenShootWorkerPoolVolumeSize     = "garden_shoot_worker_pool_volume_size_bytes"
+	metricGardenShootWorkerPoolZoneCount      = "garden_shoot_worker_pool_zone_count"
+	metricGardenShootWorkerTaintInfo          = "garden_shoot_worker_taint_info"
+	metricGardenShootWorkerless               = "garden_shoot_workerless"
+	metricGardenShootsAge                     = "garden_shoots_age_seconds"
+	metricGardenShootsByPurpose               = "garden_shoots_by_purpose"
 
 	// Aggregated Shoot metrics (exclude Shoots which act as Seed).
 	metricGardenOperationsTotal = "garden_shoot_operations_total"
@@ -49,47 +157,271 @@ const (
 
 func getGardenMetricsDefinitions() map[string]*prometheus.Desc {
 	return map[string]*prometheus.Desc{
+		metricGardenInformerCacheSize: prometheus.NewDesc(metricGardenInformerCacheSize, "Count of objects in the informer cache of the exporter.", []string{"resource"}, nil),
+
+		metricGardenBackupBucketInfo: prometheus.NewDesc(metricGardenBackupBucketInfo, "Information about a BackupBucket.", []string{"name", "provider", "region", "seed"}, nil),
+
+		metricGardenBackupBucketLastError: prometheus.NewDesc(metricGardenBackupBucketLastError, "Error codes of the last error of a BackupBucket. Errors without a well-defined code are exposed with code unknown.", []string{"name", "code"}, nil),
+
+		metricGardenBackupBucketOperationState: prometheus.NewDesc(metricGardenBackupBucketOperationState, "State of the last operation of a BackupBucket.", []string{"name", "operation"}, nil),
+
+		metricGardenBackupEntryDeletion: prometheus.NewDesc(metricGardenBackupEntryDeletion, "Timestamp of the deletion of a BackupEntry. Only provided for BackupEntries which are in deletion.", []string{"name", "project"}, nil),
+
+		metricGardenBackupEntryInfo: prometheus.NewDesc(metricGardenBackupEntryInfo, "Information about a BackupEntry. The shoot is unknown if the BackupEntry is not owned by a Shoot anymore.", []string{"name", "project", "shoot", "seed", "bucket"}, nil),
+
+		metricGardenBackupEntryOperationState: prometheus.NewDesc(metricGardenBackupEntryOperationState, "State of the last operation of a BackupEntry.", []string{"name", "project", "operation"}, nil),
+
+		metricGardenCloudProfileImageExpiration: prometheus.NewDesc(metricGardenCloudProfileImageExpiration, "Expiration timestamp of a machine image version offered by a CloudProfile. Only provided for versions with an expiration date.", []string{"profile", "image", "version", "classification"}, nil),
+
+		metricGardenCloudProfileImageVersion: prometheus.NewDesc(metricGardenCloudProfileImageVersion, "Machine image versions offered by a CloudProfile and their classification.", []string{"profile", "image", "version", "classification"}, nil),
+
+		metricGardenCloudProfileKubernetesExpiration: prometheus.NewDesc(metricGardenCloudProfileKubernetesExpiration, "Expiration timestamp of a Kubernetes version offered by a CloudProfile. Only provided for versions with an expiration date.", []string{"profile", "version", "classification"}, nil),
+
+		metricGardenCloudProfileKubernetesVersion: prometheus.NewDesc(metricGardenCloudProfileKubernetesVersion, "Kubernetes versions offered by a CloudProfile and their classification.", []string{"profile", "version", "classification"}, nil),
+
+		metricGardenCloudProfileMachineResource: prometheus.NewDesc(metricGardenCloudProfileMachineResource, "Capacity of a machine type offered by a CloudProfile. CPU is exposed in cores, memory in bytes and GPU as count.", []string{"profile", "machine_type", "resource"}, nil),
+
+		metricGardenCloudProfileMachineType: prometheus.NewDesc(metricGardenCloudProfileMachineType, "Machine types offered by a CloudProfile and if they are usable for new worker pools.", []string{"profile", "machine_type", "usable"}, nil),
+
+		metricGardenCloudProfileRegionInfo: prometheus.NewDesc(metricGardenCloudProfileRegionInfo, "Regions and zones offered by a CloudProfile.", []string{"profile", "region", "zone"}, nil),
+
+		metricGardenControllerInstallationCondition: prometheus.NewDesc(metricGardenControllerInstallationCondition, "Condition state of a ControllerInstallation. Possible values: -1=Unknown|0=Unhealthy|1=Healthy|2=Progressing", []string{"name", "registration", "seed", "condition"}, nil),
+
+		metricGardenControllerRegistrationInfo: prometheus.NewDesc(metricGardenControllerRegistrationInfo, "Resource kinds and types a ControllerRegistration is responsible for.", []string{"name", "kind", "type", "globally_enabled", "deployment_type"}, nil),
+
+		metricGardenExtensionVersionMismatch: prometheus.NewDesc(metricGardenExtensionVersionMismatch, "Indicates if the extension installed on a Seed lags behind its ControllerRegistration. Possible values: 0=Up-to-date|1=Lagging", []string{"registration", "seed"}, nil),
+
 		metricGardenOperationsTotal: prometheus.NewDesc(metricGardenOperationsTotal, "Count of ongoing operations.", []string{"operation", "state", "iaas", "seed", "version", "region"}, nil),
 
 		metricGardenPlantCondition: prometheus.NewDesc(metricGardenPlantCondition, "Condition state of a Plant. Possible values: -1=Unknown|0=Unhealthy|1=Healthy|2=Progressing", []string{"name", "project", "condition"}, nil),
 
 		metricGardenPlantInfo: prometheus.NewDesc(metricGardenPlantInfo, "Information about a plant.", []string{"name", "project", "provider", "region", "version"}, nil),
 
+		metricGardenPlantsTotal: prometheus.NewDesc(metricGardenPlantsTotal, "Count of Plants per provider and health state. A Plant is healthy if all of its conditions are True.", []string{"provider", "healthy"}, nil),
+
+		metricGardenProjectCreation: prometheus.NewDesc(metricGardenProjectCreation, "Timestamp of the creation of a project.", []string{"project"}, nil),
+
+		metricGardenProjectMembers: prometheus.NewDesc(metricGardenProjectMembers, "Count of members of a project per role. Members with multiple roles are counted for each of them.", []string{"project", "role"}, nil),
+
+		metricGardenProjectQuotaExceeded: prometheus.NewDesc(metricGardenProjectQuotaExceeded, "Indicates if the resources allocated by the Shoots of a project exceed a project scoped Quota. Possible values: 0=No|1=Yes", []string{"project", "resource"}, nil),
+
+		metricGardenProjectQuotaHard: prometheus.NewDesc(metricGardenProjectQuotaHard, "Hard limits of the ResourceQuotas in the namespace of a project.", []string{"project", "quota", "resource"}, nil),
+
+		metricGardenProjectQuotaUsed: prometheus.NewDesc(metricGardenProjectQuotaUsed, "Usage of the ResourceQuotas in the namespace of a project.", []string{"project", "quota", "resource"}, nil),
+
+		metricGardenProjectShootsPurpose: prometheus.NewDesc(metricGardenProjectShootsPurpose, "Count of Shoots per purpose in a project.", []string{"project", "purpose"}, nil),
+
 		metricGardenProjectsStatus: prometheus.NewDesc(metricGardenProjectsStatus, "Status of projects.", []string{"name", "cluster", "phase"}, nil),
 
+		metricGardenQuotaClusterLifetime: prometheus.NewDesc(metricGardenQuotaClusterLifetime, "Lifetime in days of the clusters created with a Quota. Only provided for Quotas which limit the lifetime.", []string{"name", "namespace"}, nil),
+
+		metricGardenQuotaInfo: prometheus.NewDesc(metricGardenQuotaInfo, "Information about a Quota. The scope is the kind of object the Quota applies to.", []string{"name", "namespace", "scope"}, nil),
+
+		metricGardenQuotaLimit: prometheus.NewDesc(metricGardenQuotaLimit, "Resource limits of a Quota. Quantities are exposed as absolute value, e.g. memory in bytes and CPU in cores.", []string{"name", "namespace", "resource"}, nil),
+
+		metricGardenSecretBindingCreation: prometheus.NewDesc(metricGardenSecretBindingCreation, "Timestamp of the creation of a SecretBinding.", []string{"name", "project"}, nil),
+
+		metricGardenSecretBindingInfo: prometheus.NewDesc(metricGardenSecretBindingInfo, "Secret referenced by a SecretBinding.", []string{"name", "project", "secret_namespace", "secret_name"}, nil),
+
+		metricGardenSecretBindingQuota: prometheus.NewDesc(metricGardenSecretBindingQuota, "Quotas referenced by a SecretBinding.", []string{"name", "project", "quota_namespace", "quota_name"}, nil),
+
+		metricGardenSecretBindingShoots: prometheus.NewDesc(metricGardenSecretBindingShoots, "Count of Shoots using a SecretBinding.", []string{"name", "project"}, nil),
+
 		metricGardenSeedCondition: prometheus.NewDesc(metricGardenSeedCondition, "Condition state of a Seed.", []string{"name", "condition"}, nil),
 
+		metricGardenSeedConditionStatus: prometheus.NewDesc(metricGardenSeedConditionStatus, "Condition of a Seed with one series per possible status. The series of the current status has the value 1, all others 0.", []string{"name", "condition", "status"}, nil),
+
+		metricGardenSeedConditionStale: prometheus.NewDesc(metricGardenSeedConditionStale, "Indicates if a condition of a Seed has not been updated within the stale threshold. Possible values: 0=Up-to-date|1=Stale", []string{"name", "condition"}, nil),
+
+		metricGardenSeedAcceptingShoots: prometheus.NewDesc(metricGardenSeedAcceptingShoots, "Indicates if a Seed accepts new Shoots. Invisible Seeds and Seeds in deletion do not. Possible values: 0=No|1=Yes", []string{"name"}, nil),
+
 		metricGardenSeedInfo: prometheus.NewDesc(metricGardenSeedInfo, "Information about a Seed.", []string{"name", "namespace", "iaas", "region", "visible", "protected"}, nil),
 
+		metricGardenSeedsByGardenletVersion: prometheus.NewDesc(metricGardenSeedsByGardenletVersion, "Count of Seeds per version of the gardenlet which last acted on them.", []string{"version"}, nil),
+
+		metricGardenSeedUnhealthyExtensions: prometheus.NewDesc(metricGardenSeedUnhealthyExtensions, "Count of ControllerInstallations on a Seed which are not healthy.", []string{"seed"}, nil),
+
+		metricGardenShootAlertReceiverInfo: prometheus.NewDesc(metricGardenShootAlertReceiverInfo, "Alerting email receivers configured for a Shoot.", []string{"name", "project", "receiver"}, nil),
+
+		metricGardenShootBackupOK: prometheus.NewDesc(metricGardenShootBackupOK, "Indicates if the BackupEntry of a Shoot has been reconciled successfully. Possible values: 0=Unhealthy|1=Healthy", []string{"name", "project"}, nil),
+
 		metricGardenShootCondition: prometheus.NewDesc(metricGardenShootCondition, "Condition state of a Shoot. Possible values: -1=Unknown|0=Unhealthy|1=Healthy|2=Progressing", []string{"name", "project", "condition", "operation", "purpose", "is_seed", "iaas"}, nil),
 
+		metricGardenShootAuditPolicyConfigured: prometheus.NewDesc(metricGardenShootAuditPolicyConfigured, "Indicates if an audit policy ConfigMap is referenced for the kube apiserver of a Shoot. Possible values: 0=No|1=Yes", []string{"name", "project"}, nil),
+
+		metricGardenShootAutoUpdateEnabled: prometheus.NewDesc(metricGardenShootAutoUpdateEnabled, "Auto update settings of a Shoot. Possible values: 0=Disabled|1=Enabled", []string{"name", "project", "type"}, nil),
+
+		metricGardenShootAutoscalerDuration: prometheus.NewDesc(metricGardenShootAutoscalerDuration, "Durations of the cluster autoscaler of a Shoot in seconds. Only provided for settings which override the defaults.", []string{"name", "project", "setting"}, nil),
+
+		metricGardenShootAutoscalerThreshold: prometheus.NewDesc(metricGardenShootAutoscalerThreshold, "Scale down utilization threshold of the cluster autoscaler of a Shoot. Only provided if it overrides the default.", []string{"name", "project"}, nil),
+
+		metricGardenShootCloudProfileInfo: prometheus.NewDesc(metricGardenShootCloudProfileInfo, "CloudProfile referenced by a Shoot.", []string{"name", "project", "cloudprofile"}, nil),
+
+		metricGardenShootClusterIdentityInfo: prometheus.NewDesc(metricGardenShootClusterIdentityInfo, "Cluster identity of a Shoot. The technical id of the Shoot is used, which is also the name of its namespace in the Seed.", []string{"name", "project", "cluster_identity"}, nil),
+
+		metricGardenShootAddon: prometheus.NewDesc(metricGardenShootAddon, "Indicates if an addon is enabled for a Shoot. Possible values: 0=Disabled|1=Enabled", []string{"name", "project", "addon"}, nil),
+
+		metricGardenShootAdmissionPluginInfo: prometheus.NewDesc(metricGardenShootAdmissionPluginInfo, "Admission plugins explicitly configured for the kube apiserver of a Shoot.", []string{"name", "project", "plugin"}, nil),
+
+		metricGardenShootConditionCode: prometheus.NewDesc(metricGardenShootConditionCode, "Error codes reported by a condition of a Shoot. Only provided for conditions which carry error codes.", []string{"name", "project", "condition", "code"}, nil),
+
+		metricGardenShootConditionStatus: prometheus.NewDesc(metricGardenShootConditionStatus, "Condition of a Shoot with one series per possible status. The series of the current status has the value 1, all others 0.", []string{"name", "project", "condition", "status"}, nil),
+
+		metricGardenShootConditionTransition: prometheus.NewDesc(metricGardenShootConditionTransition, "Timestamp of the last status transition of a condition of a Shoot.", []string{"name", "project", "condition"}, nil),
+
+		metricGardenShootConstraint: prometheus.NewDesc(metricGardenShootConstraint, "Constraint state of a Shoot. Possible values: -1=Unknown|0=Unsatisfied|1=Satisfied|2=Progressing", []string{"name", "project", "constraint"}, nil),
+
 		metricGardenShootCreation: prometheus.NewDesc(metricGardenShootCreation, "Timestamp of the shoot creation.", []string{"name", "project", "uid"}, nil),
 
+		metricGardenShootDeletion: prometheus.NewDesc(metricGardenShootDeletion, "Timestamp of the shoot deletion. Only provided for shoots which are in deletion.", []string{"name", "project", "uid"}, nil),
+
+		metricGardenShootDeletionConfirmed: prometheus.NewDesc(metricGardenShootDeletionConfirmed, "Indicates if the deletion of a Shoot is confirmed via annotation. Possible values: 0=No|1=Yes", []string{"name", "project"}, nil),
+
+		metricGardenShootDNSInfo: prometheus.NewDesc(metricGardenShootDNSInfo, "DNS domain and type of the primary DNS provider of a Shoot.", []string{"name", "project", "domain", "provider"}, nil),
+
+		metricGardenShootEnvironmentInfo: prometheus.NewDesc(metricGardenShootEnvironmentInfo, "Environment of a Shoot taken from the configured label of its project namespace.", []string{"name", "project", "environment"}, nil),
+
+		metricGardenShootExtensionEnabled: prometheus.NewDesc(metricGardenShootExtensionEnabled, "Extensions enabled for a Shoot, either in its specification or globally.", []string{"name", "project", "type"}, nil),
+
+		metricGardenShootFeatureGateEnabled: prometheus.NewDesc(metricGardenShootFeatureGateEnabled, "Feature gates configured for the Kubernetes components of a Shoot. Possible values: 0=Disabled|1=Enabled", []string{"name", "project", "feature_gate", "component"}, nil),
+
+		metricGardenShootForcedUpgradeImminent: prometheus.NewDesc(metricGardenShootForcedUpgradeImminent, "Indicates if the Kubernetes version of a Shoot expires within the forced upgrade window. Possible values: 0=No|1=Yes", []string{"name", "project", "version"}, nil),
+
+		metricGardenShootHealthScore: prometheus.NewDesc(metricGardenShootHealthScore, "Weighted share of the conditions and constraints of a Shoot which are True, ranging from 0 to 1.", []string{"name", "project"}, nil),
+
 		metricGardenShootHibernated: prometheus.NewDesc(metricGardenShootHibernated, "Hibernation status of a shoot.", []string{"name", "project", "uid"}, nil),
 
+		metricGardenShootIgnored: prometheus.NewDesc(metricGardenShootIgnored, "Indicates if a Shoot is annotated to be ignored, i.e. it is not reconciled by gardenlets which respect the annotation. Possible values: 0=No|1=Yes", []string{"name", "project"}, nil),
+
+		metricGardenShootHibernationNextStart: prometheus.NewDesc(metricGardenShootHibernationNextStart, "Timestamp of the next start of the hibernation of a Shoot according to its hibernation schedules.", []string{"name", "project"}, nil),
+
+		metricGardenShootHibernationNextStop: prometheus.NewDesc(metricGardenShootHibernationNextStop, "Timestamp of the next stop of the hibernation of a Shoot, i.e. the next wake up, according to its hibernation schedules.", []string{"name", "project"}, nil),
+
 		metricGardenShootInfo: prometheus.NewDesc(metricGardenShootInfo, "Information about a Shoot.", []string{"name", "project", "iaas", "version", "region", "seed", "is_seed"}, nil),
 
+		metricGardenShootKubernetesVersion: prometheus.NewDesc(metricGardenShootKubernetesVersion, "Kubernetes version of a Shoot encoded as major*10000+minor*100+patch, e.g. 11802 for 1.18.2.", []string{"name", "project", "major", "minor"}, nil),
+
+		metricGardenShootLastError: prometheus.NewDesc(metricGardenShootLastError, "Count of last errors of a Shoot per error code. Errors without a well-defined code are exposed with code unknown.", []string{"name", "project", "code"}, nil),
+
+		metricGardenShootLastHealthyTimestamp: prometheus.NewDesc(metricGardenShootLastHealthyTimestamp, "Timestamp when all conditions of a Shoot were last observed as healthy. Not provided for Shoots which have not been healthy since the start of the exporter.", []string{"name", "project"}, nil),
+
+		metricGardenShootLastOperationLastUpdate: prometheus.NewDesc(metricGardenShootLastOperationLastUpdate, "Timestamp of the last update of the last operation of a Shoot.", []string{"name", "project", "operation", "state"}, nil),
+
+		metricGardenShootLastOperationSinceUpdate: prometheus.NewDesc(metricGardenShootLastOperationSinceUpdate, "Seconds since the last update of the last operation of a Shoot. This is not the duration of the operation, for ongoing operations it is the time without progress.", []string{"name", "project", "operation", "state"}, nil),
+
+		metricGardenShootLegacyAuthEnabled: prometheus.NewDesc(metricGardenShootLegacyAuthEnabled, "Legacy authentication methods enabled for the kube apiserver of a Shoot. Only exposed for enabled methods.", []string{"name", "project", "type"}, nil),
+
+		metricGardenShootMaintenanceWindowInfo: prometheus.NewDesc(metricGardenShootMaintenanceWindowInfo, "Maintenance time window of a Shoot. Begin and end are in the format HHMMSS+ZZZZ.", []string{"name", "project", "begin", "end"}, nil),
+
+		metricGardenShootMigrationInProgress: prometheus.NewDesc(metricGardenShootMigrationInProgress, "Indicates if the control plane of a Shoot is migrated from the source to the target Seed. Possible values: 0=No|1=Yes", []string{"name", "project", "source_seed", "target_seed"}, nil),
+
+		metricGardenShootNetworkingInfo: prometheus.NewDesc(metricGardenShootNetworkingInfo, "Networking configuration of a Shoot. The IP families are derived from the configured CIDRs.", []string{"name", "project", "type", "pods", "services", "nodes", "ip_families"}, nil),
+
+		metricGardenShootNodeCIDRMaskSize: prometheus.NewDesc(metricGardenShootNodeCIDRMaskSize, "Mask size of the node CIDRs of a Shoot, which limits the count of pods per node.", []string{"name", "project"}, nil),
+
 		metricGardenShootNodeMaxTotal: prometheus.NewDesc(metricGardenShootNodeMaxTotal, "Max node count of a Shoot.", []string{"name", "project"}, nil),
 
 		metricGardenShootNodeMinTotal: prometheus.NewDesc(metricGardenShootNodeMinTotal, "Min node count of a Shoot.", []string{"name", "project"}, nil),
 
+		metricGardenShootOIDCConfigured: prometheus.NewDesc(metricGardenShootOIDCConfigured, "Indicates if OIDC authentication is configured for the kube apiserver of a Shoot. The issuer is empty if not configured. Possible values: 0=No|1=Yes", []string{"name", "project", "issuer"}, nil),
+
 		metricGardenShootOperationProgressPercent: prometheus.NewDesc(metricGardenShootOperationProgressPercent, "Operation progress percent of a Shoot.", []string{"name", "project", "operation"}, nil),
 
 		metricGardenShootOperationState: prometheus.NewDesc(metricGardenShootOperationState, "Operation state of a Shoot.", []string{"name", "project", "operation"}, nil),
 
+		metricGardenShootPurpose: prometheus.NewDesc(metricGardenShootPurpose, "Purpose of a Shoot.", []string{"name", "project", "purpose"}, nil),
+
 		metricGardenShootResponseDuration: prometheus.NewDesc(metricGardenShootResponseDuration, "Response time of the Shoot API server. Not provided when not reachable.", []string{"name", "project"}, nil),
 
+		metricGardenShootServiceAccountIssuerInfo: prometheus.NewDesc(metricGardenShootServiceAccountIssuerInfo, "Service account issuer configured for the kube apiserver of a Shoot.", []string{"name", "project", "issuer"}, nil),
+
+		metricGardenShootUnscheduled: prometheus.NewDesc(metricGardenShootUnscheduled, "Indicates that a Shoot is not scheduled to a Seed yet. The reason is the description of the last operation or pending.", []string{"name", "project", "reason"}, nil),
+
+		metricGardenShootWorkerEvictionHard: prometheus.NewDesc(metricGardenShootWorkerEvictionHard, "Hard eviction threshold of the kubelets in a worker pool of a Shoot. Thresholds in percent are exposed as ratio between 0 and 1, quantities as absolute value.", []string{"name", "project", "worker_pool", "signal"}, nil),
+
+		metricGardenShootWorkerPoolImageInfo: prometheus.NewDesc(metricGardenShootWorkerPoolImageInfo, "Machine image and version of a worker pool of a Shoot.", []string{"name", "project", "worker_pool", "image", "version"}, nil),
+
+		metricGardenShootWorkerPoolMaxSurge: prometheus.NewDesc(metricGardenShootWorkerPoolMaxSurge, "Max surge of a worker pool of a Shoot. Percentages are resolved against the maximum node count of the pool.", []string{"name", "project", "worker_pool"}, nil),
+
+		metricGardenShootWorkerPoolNodeMax: prometheus.NewDesc(metricGardenShootWorkerPoolNodeMax, "Max node count of a worker pool of a Shoot.", []string{"name", "project", "worker_pool"}, nil),
+
+		metricGardenShootWorkerPoolNodeMin: prometheus.NewDesc(metricGardenShootWorkerPoolNodeMin, "Min node count of a worker pool of a Shoot.", []string{"name", "project", "worker_pool"}, nil),
+
+		metricGardenShootWorkerPoolVolumeSize: prometheus.NewDesc(metricGardenShootWorkerPoolVolumeSize, "Size in bytes of the root volume of each machine in a worker pool of a Shoot.", []string{"name", "project", "worker_pool", "type"}, nil),
+
+		metricGardenShootWorkerPoolZoneCount: prometheus.NewDesc(metricGardenShootWorkerPoolZoneCount, "Count of zones of a worker pool of a Shoot.", []string{"name", "project", "worker_pool"}, nil),
+
+		metricGardenShootWorkerTaintInfo: prometheus.NewDesc(metricGardenShootWorkerTaintInfo, "Taints configured for a worker pool of a Shoot.", []string{"name", "project", "worker_pool", "key", "effect"}, nil),
+
+		metricGardenShootWorkerless: prometheus.NewDesc(metricGardenShootWorkerless, "Indicates if a Shoot has no worker pools. Possible values: 0=No|1=Yes", []string{"name", "project"}, nil),
+
+		metricGardenShootsByPurpose: prometheus.NewDesc(metricGardenShootsByPurpose, "Count of Shoots per purpose.", []string{"purpose"}, nil),
+
+		metricGardenShootsAge: prometheus.NewDesc(metricGardenShootsAge, "Age distribution of the Shoots which are not in deletion.", nil, nil),
+
 		metricGardenUsersSum: prometheus.NewDesc(metricGardenUsersSum, "Count of users.", []string{"kind"}, nil),
 	}
 }
 
 type gardenMetricsCollector struct {
-	shootInformer   gardencoreinformers.ShootInformer
-	seedInformer    gardencoreinformers.SeedInformer
-	projectInformer gardencoreinformers.ProjectInformer
-	plantInformer   gardencoreinformers.PlantInformer
-	descs           map[string]*prometheus.Desc
-	logger          *logrus.Logger
+	shootInformer                  gardencoreinformers.ShootInformer
+	seedInformer                   gardencoreinformers.SeedInformer
+	projectInformer                gardencoreinformers.ProjectInformer
+	plantInformer                  gardencoreinformers.PlantInformer
+	cloudProfileInformer           gardencoreinformers.CloudProfileInformer
+	controllerInstallationInformer gardencoreinformers.ControllerInstallationInformer
+	controllerRegistrationInformer gardencoreinformers.ControllerRegistrationInformer
+	backupBucketInformer           gardencoreinformers.BackupBucketInformer
+	backupEntryInformer            gardencoreinformers.BackupEntryInformer
+	quotaInformer                  gardencoreinformers.QuotaInformer
+	secretBindingInformer          gardencoreinformers.SecretBindingInformer
+	namespaceInformer              kubecoreinformers.NamespaceInformer
+	resourceQuotaInformer          kubecoreinformers.ResourceQuotaInformer
+	descs                          map[string]*prometheus.Desc
+	logger                         *logrus.Logger
+
+	collectInterval             time.Duration
+	cache                       *metricsCache
+	healthTracker               *shootHealthTracker
+	operationTracker            *shootOperationTracker
+	seriesTracker               *shootSeriesTracker
+	seedConditionStaleThreshold time.Duration
+	forcedUpgradeWindow         time.Duration
+	onlyRecentlyChanged         time.Duration
+	shardIndex                  uint32
+	shardTotal                  uint32
+	environmentLabel            string
+	conditionStatusSeries       bool
+	healthScoreWeights          map[string]int
+}
+
+// Options contains configuration settings for the metrics collector.
+type Options struct {
+	// CollectInterval is the interval in which the metrics are recomputed in the background.
+	// If not set, the metrics are computed on each scrape.
+	CollectInterval time.Duration
+	// SeedConditionStaleThreshold is the duration after which a Seed condition, which has not been updated, is considered stale.
+	SeedConditionStaleThreshold time.Duration
+	// ForcedUpgradeWindow is the duration before the expiration of a Kubernetes version in which a forced upgrade of a Shoot is considered imminent.
+	ForcedUpgradeWindow time.Duration
+	// OnlyRecentlyChanged restricts the detailed Shoot metrics to Shoots which have changed within the given window.
+	// Aggregated metrics still consider all Shoots. If not set, the detailed metrics are exposed for all Shoots.
+	OnlyRecentlyChanged time.Duration
+	// ShardIndex is the index of the shard of Shoots handled by this exporter. It must be lower than ShardTotal.
+	ShardIndex int
+	// ShardTotal is the count of shards the Shoots are distributed to based on the hash of their uid.
+	// The Shoot metrics of each exporter, including the aggregated ones, only consider the Shoots
+	// of its shard. If not set, all Shoots are handled by the exporter.
+	ShardTotal int
+	// EnvironmentLabel is the label on the project namespaces which contains the environment of the Shoots.
+	// If not set, the environment of the Shoots is not exposed.
+	EnvironmentLabel string
+	// ConditionStatusSeries enables the additional encoding of the Shoot and Seed conditions as one
+	// series per possible status, similar to the kube_*_status_condition metrics of kube-state-metrics.
+	ConditionStatusSeries bool
+	// HealthScoreWeights are the weights of the condition and constraint types for the health score of the Shoots.
+	// Types which are not contained have the weight 1.
+	HealthScoreWeights map[string]int
 }
 
 // Describe implements the prometheus.Describe interface, which intends the gardenMetricsCollector to be a Prometheus collector.
@@ -101,24 +433,77 @@ func (c *gardenMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 }
 
 // Collect implements the prometheus.Collect interface, which intends the gardenMetricsCollector to be a Prometheus collector.
-// TODO Can we run the collectors in parallel?
 func (c *gardenMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	// Serve the metrics from the cache in case they are recomputed in the background.
+	if c.collectInterval > 0 {
+		for _, metric := range c.cache.get() {
+			ch <- metric
+		}
+		return
+	}
+	c.collect(ch)
+}
+
+// collect runs all collectors and passes the resulting metrics to the given channel.
+// TODO Can we run the collectors in parallel?
+func (c *gardenMetricsCollector) collect(ch chan<- prometheus.Metric) {
 	c.collectProjectMetrics(ch)
+	c.collectProjectResourceQuotaMetrics(ch)
 	c.collectShootMetrics(ch)
 	c.collectSeedMetrics(ch)
 	c.collectPlantMetrics(ch)
+	c.collectControllerInstallationMetrics(ch)
+	c.collectControllerRegistrationMetrics(ch)
+	c.collectQuotaMetrics(ch)
+	c.collectBackupBucketMetrics(ch)
+	c.collectBackupEntryMetrics(ch)
+	c.collectSecretBindingMetrics(ch)
+	c.collectCloudProfileMetrics(ch)
+	c.collectInformerMetrics(ch)
 }
 
 // SetupMetricsCollector takes informers to configure the metrics collectors.
-func SetupMetricsCollector(shootInformer gardencoreinformers.ShootInformer, seedInformer gardencoreinformers.SeedInformer, projectInformer gardencoreinformers.ProjectInformer, plantInformer gardencoreinformers.PlantInformer, logger *logrus.Logger) {
+// It returns a handler which explains the metric values of a single Shoot.
+func SetupMetricsCollector(ctx context.Context, informers gardencoreinformers.Interface, kubeInformers kubecoreinformers.Interface, options Options, logger *logrus.Logger) *ExplainHandler {
 	metricsCollector := gardenMetricsCollector{
-		shootInformer:   shootInformer,
-		seedInformer:    seedInformer,
-		projectInformer: projectInformer,
-		plantInformer:   plantInformer,
-		descs:           getGardenMetricsDefinitions(),
-		logger:          logger,
+		shootInformer:                  informers.Shoots(),
+		seedInformer:                   informers.Seeds(),
+		projectInformer:                informers.Projects(),
+		plantInformer:                  informers.Plants(),
+		cloudProfileInformer:           informers.CloudProfiles(),
+		controllerInstallationInformer: informers.ControllerInstallations(),
+		controllerRegistrationInformer: informers.ControllerRegistrations(),
+		backupBucketInformer:           informers.BackupBuckets(),
+		backupEntryInformer:            informers.BackupEntries(),
+		quotaInformer:                  informers.Quotas(),
+		secretBindingInformer:          informers.SecretBindings(),
+		resourceQuotaInformer:          kubeInformers.ResourceQuotas(),
+		descs:                          getGardenMetricsDefinitions(),
+		logger:                         logger,
+		collectInterval:                options.CollectInterval,
+		cache:                          &metricsCache{},
+		healthTracker:                  newShootHealthTracker(),
+		operationTracker:               newShootOperationTracker(),
+		seriesTracker:                  newShootSeriesTracker(),
+		seedConditionStaleThreshold:    options.SeedConditionStaleThreshold,
+		forcedUpgradeWindow:            options.ForcedUpgradeWindow,
+		onlyRecentlyChanged:            options.OnlyRecentlyChanged,
+		shardIndex:                     uint32(options.ShardIndex),
+		shardTotal:                     uint32(options.ShardTotal),
+		environmentLabel:               options.EnvironmentLabel,
+		conditionStatusSeries:          options.ConditionStatusSeries,
+		healthScoreWeights:             options.HealthScoreWeights,
 	}
+	if metricsCollector.environmentLabel != "" {
+		metricsCollector.namespaceInformer = kubeInformers.Namespaces()
+	}
+	if metricsCollector.collectInterval > 0 {
+		go metricsCollector.runCollectLoop(ctx)
+	}
+	metricsCollector.registerShootEventHandlers(time.Now().Truncate(time.Second))
 	prometheus.MustRegister(&metricsCollector)
 	prometheus.MustRegister(ScrapeFailures)
+	prometheus.MustRegister(shootsCreated, shootsDeleted, shootCreations, shootDeletions, shootCreationDuration, shootHibernationTransitions, shootConditionTransitions, shootOperationDuration)
+
+	return &ExplainHandler{collector: &metricsCollector}
 }
@@ -0,0 +1,229 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubeinformers "k8s.io/client-go/informers"
+)
+
+var registerCustomizationMetrics sync.Once
+
+// newTestCollector returns a collector whose informers are not started. The passed
+// objects are added to the stores of the informers directly.
+func newTestCollector(t *testing.T, objects ...runtime.Object) *gardenMetricsCollector {
+	t.Helper()
+	// The descriptions of the customization metrics are created when they are registered.
+	registerCustomizationMetrics.Do(func() {
+		ch := make(chan *prometheus.Desc)
+		go func() {
+			registerShootCustomizationMetrics(ch)
+			close(ch)
+		}()
+		for range ch {
+		}
+	})
+
+	var (
+		informers     = gardencoreinformers.NewSharedInformerFactory(nil, 0).Core().V1beta1()
+		kubeInformers = kubeinformers.NewSharedInformerFactory(nil, 0).Core().V1()
+	)
+	c := &gardenMetricsCollector{
+		shootInformer:                  informers.Shoots(),
+		seedInformer:                   informers.Seeds(),
+		projectInformer:                informers.Projects(),
+		plantInformer:                  informers.Plants(),
+		cloudProfileInformer:           informers.CloudProfiles(),
+		controllerInstallationInformer: informers.ControllerInstallations(),
+		controllerRegistrationInformer: informers.ControllerRegistrations(),
+		backupBucketInformer:           informers.BackupBuckets(),
+		backupEntryInformer:            informers.BackupEntries(),
+		quotaInformer:                  informers.Quotas(),
+		secretBindingInformer:          informers.SecretBindings(),
+		resourceQuotaInformer:          kubeInformers.ResourceQuotas(),
+		descs:                          getGardenMetricsDefinitions(),
+		logger:                         newTestLogger(),
+		cache:                          &metricsCache{},
+		healthTracker:                  newShootHealthTracker(),
+		operationTracker:               newShootOperationTracker(),
+		seriesTracker:                  newShootSeriesTracker(),
+	}
+	for _, obj := range objects {
+		var err error
+		switch o := obj.(type) {
+		case *gardenv1beta1.Shoot:
+			err = c.shootInformer.Informer().GetIndexer().Add(o)
+		case *gardenv1beta1.Project:
+			err = c.projectInformer.Informer().GetIndexer().Add(o)
+		case *gardenv1beta1.Seed:
+			err = c.seedInformer.Informer().GetIndexer().Add(o)
+		case *gardenv1beta1.Plant:
+			err = c.plantInformer.Informer().GetIndexer().Add(o)
+		case *gardenv1beta1.CloudProfile:
+			err = c.cloudProfileInformer.Informer().GetIndexer().Add(o)
+		case *gardenv1beta1.BackupEntry:
+			err = c.backupEntryInformer.Informer().GetIndexer().Add(o)
+		case *gardenv1beta1.Quota:
+			err = c.quotaInformer.Informer().GetIndexer().Add(o)
+		case *gardenv1beta1.SecretBinding:
+			err = c.secretBindingInformer.Informer().GetIndexer().Add(o)
+		case *gardenv1beta1.ControllerInstallation:
+			err = c.controllerInstallationInformer.Informer().GetIndexer().Add(o)
+		case *gardenv1beta1.ControllerRegistration:
+			err = c.controllerRegistrationInformer.Informer().GetIndexer().Add(o)
+		default:
+			t.Fatalf("unsupported object %T", obj)
+		}
+		if err != nil {
+			t.Fatalf("cannot add object: %s", err.Error())
+		}
+	}
+	return c
+}
+
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+	return logger
+}
+
+// assertValues checks that the collected values match the expected ones exactly.
+func assertValues(t *testing.T, got, want map[string]float64) {
+	t.Helper()
+	for series, value := range want {
+		if gotValue, ok := got[series]; !ok {
+			t.Errorf("series %s is missing", series)
+		} else if gotValue != value {
+			t.Errorf("series %s: got %v, want %v", series, gotValue, value)
+		}
+	}
+	for series := range got {
+		if _, ok := want[series]; !ok {
+			t.Errorf("unexpected series %s", series)
+		}
+	}
+}
+
+func newTestProject(name, namespace string) *gardenv1beta1.Project {
+	project := &gardenv1beta1.Project{}
+	project.Name = name
+	project.Spec.Namespace = &namespace
+	return project
+}
+
+func newTestShoot(namespace, name, uid string) *gardenv1beta1.Shoot {
+	var (
+		seed    = "aws-eu1"
+		purpose = gardenv1beta1.ShootPurposeEvaluation
+		shoot   = &gardenv1beta1.Shoot{}
+	)
+	shoot.Namespace, shoot.Name, shoot.UID = namespace, name, types.UID(uid)
+	shoot.CreationTimestamp = metav1.Now()
+	shoot.Spec.SeedName = &seed
+	shoot.Spec.Purpose = &purpose
+	shoot.Spec.Provider.Type = "aws"
+	shoot.Spec.Region = "eu-west-1"
+	shoot.Spec.Kubernetes.Version = "1.16.8"
+	shoot.Status.LastOperation = &gardenv1beta1.LastOperation{
+		Type:           gardenv1beta1.LastOperationTypeReconcile,
+		State:          gardenv1beta1.LastOperationStateProcessing,
+		LastUpdateTime: metav1.Now(),
+	}
+	shoot.Status.Conditions = []gardenv1beta1.Condition{
+		{Type: gardenv1beta1.ShootAPIServerAvailable, Status: gardenv1beta1.ConditionTrue},
+		{Type: gardenv1beta1.ShootControlPlaneHealthy, Status: gardenv1beta1.ConditionFalse, Reason: "DeploymentUnhealthy"},
+	}
+	return shoot
+}
+
+// collectSeries collects the metrics of the collect function and returns them by their
+// description and their sorted label pairs.
+func collectSeries(collect func(ch chan<- prometheus.Metric)) map[*prometheus.Desc]map[string]float64 {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		collect(ch)
+		close(ch)
+	}()
+
+	series := make(map[*prometheus.Desc]map[string]float64)
+	for metric := range ch {
+		m := &dto.Metric{}
+		if err := metric.Write(m); err != nil || m.Gauge == nil {
+			continue
+		}
+		if _, ok := series[metric.Desc()]; !ok {
+			series[metric.Desc()] = make(map[string]float64)
+		}
+		series[metric.Desc()][labelPairs(m.Label)] = m.Gauge.GetValue()
+	}
+	return series
+}
+
+func labelPairs(labels []*dto.LabelPair) string {
+	pairs := make([]string, 0, len(labels))
+	for _, label := range labels {
+		pairs = append(pairs, label.GetName()+"="+label.GetValue())
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// collectValues collects the metrics of the collector and returns their values by their
+// sorted label pairs, e.g. "name=foo,project=bar". Histograms are returned with their sample count.
+func collectValues(collector prometheus.Collector) map[string]float64 {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		collector.Collect(ch)
+		close(ch)
+	}()
+
+	values := make(map[string]float64)
+	for metric := range ch {
+		m := &dto.Metric{}
+		if err := metric.Write(m); err != nil {
+			continue
+		}
+		pairs := make([]string, 0, len(m.Label))
+		for _, label := range m.Label {
+			pairs = append(pairs, label.GetName()+"="+label.GetValue())
+		}
+		sort.Strings(pairs)
+
+		var value float64
+		switch {
+		case m.Counter != nil:
+			value = m.Counter.GetValue()
+		case m.Gauge != nil:
+			value = m.Gauge.GetValue()
+		case m.Histogram != nil:
+			value = float64(m.Histogram.GetSampleCount())
+		}
+		values[strings.Join(pairs, ",")] = value
+	}
+	return values
+}
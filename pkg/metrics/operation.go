@@ -0,0 +1,87 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// shootOperationTracker remembers when the currently running operation of each Shoot started,
+// as the last operation of a Shoot only contains the time of its last update.
+type shootOperationTracker struct {
+	sync.Mutex
+	starts map[types.UID]operationStart
+}
+
+type operationStart struct {
+	operationType gardenv1beta1.LastOperationType
+	time          time.Time
+}
+
+func newShootOperationTracker() *shootOperationTracker {
+	return &shootOperationTracker{starts: make(map[types.UID]operationStart)}
+}
+
+// observe compares the last operations of the old and the new version of the Shoot. It records the start
+// of a new operation and returns the duration of an operation which succeeded since the old version.
+func (t *shootOperationTracker) observe(oldShoot, newShoot *gardenv1beta1.Shoot) (gardenv1beta1.LastOperationType, time.Duration, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	oldOperation, newOperation := oldShoot.Status.LastOperation, newShoot.Status.LastOperation
+	if newOperation == nil {
+		return "", 0, false
+	}
+
+	if operationFinished(newOperation.State) {
+		start, ok := t.starts[newShoot.UID]
+		delete(t.starts, newShoot.UID)
+		// Only succeeded operations are observed, the durations of failed or aborted ones are not meaningful.
+		if !ok || start.operationType != newOperation.Type || newOperation.State != gardenv1beta1.LastOperationStateSucceeded {
+			return "", 0, false
+		}
+		return newOperation.Type, newOperation.LastUpdateTime.Sub(start.time), true
+	}
+
+	// A new operation started if the type changed or the previous operation has finished.
+	if oldOperation == nil || oldOperation.Type != newOperation.Type || operationFinished(oldOperation.State) {
+		startTime := newOperation.LastUpdateTime.Time
+		// The create operation starts with the creation of the Shoot.
+		if newOperation.Type == gardenv1beta1.LastOperationTypeCreate {
+			startTime = newShoot.CreationTimestamp.Time
+		}
+		t.starts[newShoot.UID] = operationStart{operationType: newOperation.Type, time: startTime}
+	}
+	return "", 0, false
+}
+
+// forget removes the operation start of the Shoot and returns it, if there was any.
+func (t *shootOperationTracker) forget(uid types.UID) (operationStart, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	start, ok := t.starts[uid]
+	delete(t.starts, uid)
+	return start, ok
+}
+
+// operationFinished checks if an operation in the given state has finished.
+func operationFinished(state gardenv1beta1.LastOperationState) bool {
+	return state == gardenv1beta1.LastOperationStateSucceeded || state == gardenv1beta1.LastOperationStateFailed || state == gardenv1beta1.LastOperationStateAborted
+}
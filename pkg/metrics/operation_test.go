@@ -0,0 +1,127 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func withLastOperation(shoot *gardenv1beta1.Shoot, operationType gardenv1beta1.LastOperationType, state gardenv1beta1.LastOperationState, lastUpdate time.Time) *gardenv1beta1.Shoot {
+	shoot = shoot.DeepCopy()
+	shoot.Status.LastOperation = &gardenv1beta1.LastOperation{Type: operationType, State: state, LastUpdateTime: metav1.NewTime(lastUpdate)}
+	return shoot
+}
+
+func TestShootOperationTracker(t *testing.T) {
+	var (
+		start = time.Date(2020, time.June, 10, 10, 0, 0, 0, time.UTC)
+		shoot = newTestShoot("garden-dev", "foo", "uid-1")
+
+		succeededCreate     = withLastOperation(shoot, gardenv1beta1.LastOperationTypeCreate, gardenv1beta1.LastOperationStateSucceeded, start)
+		processingReconcile = withLastOperation(shoot, gardenv1beta1.LastOperationTypeReconcile, gardenv1beta1.LastOperationStateProcessing, start.Add(time.Minute))
+		progressedReconcile = withLastOperation(shoot, gardenv1beta1.LastOperationTypeReconcile, gardenv1beta1.LastOperationStateProcessing, start.Add(3*time.Minute))
+		succeededReconcile  = withLastOperation(shoot, gardenv1beta1.LastOperationTypeReconcile, gardenv1beta1.LastOperationStateSucceeded, start.Add(5*time.Minute))
+		failedReconcile     = withLastOperation(shoot, gardenv1beta1.LastOperationTypeReconcile, gardenv1beta1.LastOperationStateFailed, start.Add(5*time.Minute))
+		processingDelete    = withLastOperation(shoot, gardenv1beta1.LastOperationTypeDelete, gardenv1beta1.LastOperationStateProcessing, start.Add(10*time.Minute))
+	)
+
+	tracker := newShootOperationTracker()
+
+	// The operation starts with the first update in which it is processing.
+	if _, _, ok := tracker.observe(succeededCreate, processingReconcile); ok {
+		t.Errorf("started operation is observed as finished")
+	}
+	if _, _, ok := tracker.observe(processingReconcile, progressedReconcile); ok {
+		t.Errorf("progressing operation is observed as finished")
+	}
+	operationType, duration, ok := tracker.observe(progressedReconcile, succeededReconcile)
+	if !ok || operationType != gardenv1beta1.LastOperationTypeReconcile || duration != 4*time.Minute {
+		t.Errorf("succeeded operation: got %s %s (%t), want Reconcile 4m0s", operationType, duration, ok)
+	}
+	// The start is removed once the operation finished.
+	if _, _, ok := tracker.observe(succeededReconcile, succeededReconcile); ok {
+		t.Errorf("finished operation is observed twice")
+	}
+
+	// Failed operations are not observed.
+	tracker.observe(succeededCreate, processingReconcile)
+	if _, _, ok := tracker.observe(processingReconcile, failedReconcile); ok {
+		t.Errorf("failed operation is observed")
+	}
+
+	// Operations whose start was not observed, e.g. because the exporter was restarted, are not observed.
+	if _, _, ok := tracker.observe(progressedReconcile, succeededReconcile); ok {
+		t.Errorf("operation without start is observed")
+	}
+
+	// The delete operation is returned when the Shoot is forgotten.
+	tracker.observe(succeededReconcile, processingDelete)
+	operationStart, ok := tracker.forget(shoot.UID)
+	if !ok || operationStart.operationType != gardenv1beta1.LastOperationTypeDelete || !operationStart.time.Equal(start.Add(10*time.Minute)) {
+		t.Errorf("forgotten operation: got %v (%t), want Delete started at %s", operationStart, ok, start.Add(10*time.Minute))
+	}
+	if _, ok := tracker.forget(shoot.UID); ok {
+		t.Errorf("operation is forgotten twice")
+	}
+}
+
+func TestShootOperationTrackerCreate(t *testing.T) {
+	var (
+		tracker = newShootOperationTracker()
+		shoot   = newTestShoot("garden-dev", "foo", "uid-1")
+	)
+	shoot.CreationTimestamp = metav1.NewTime(time.Date(2020, time.June, 10, 10, 0, 0, 0, time.UTC))
+	shoot.Status.LastOperation = nil
+
+	// The create operation starts with the creation of the Shoot.
+	processing := withLastOperation(shoot, gardenv1beta1.LastOperationTypeCreate, gardenv1beta1.LastOperationStateProcessing, shoot.CreationTimestamp.Add(time.Minute))
+	succeeded := withLastOperation(shoot, gardenv1beta1.LastOperationTypeCreate, gardenv1beta1.LastOperationStateSucceeded, shoot.CreationTimestamp.Add(10*time.Minute))
+
+	tracker.observe(shoot, processing)
+	if operationType, duration, ok := tracker.observe(processing, succeeded); !ok || operationType != gardenv1beta1.LastOperationTypeCreate || duration != 10*time.Minute {
+		t.Errorf("got %s %s (%t), want Create 10m0s", operationType, duration, ok)
+	}
+}
+
+func TestShootEventHandlersOperationDuration(t *testing.T) {
+	shootOperationDuration.Reset()
+
+	var (
+		start    = time.Now().Add(-time.Hour)
+		shoot    = newTestShoot("garden-dev", "foo", "uid-1")
+		c        = newTestCollector(t, newTestProject("dev", "garden-dev"))
+		handlers = c.shootEventHandlers(start)
+
+		succeededCreate     = withLastOperation(shoot, gardenv1beta1.LastOperationTypeCreate, gardenv1beta1.LastOperationStateSucceeded, start.Add(-time.Minute))
+		processingReconcile = withLastOperation(shoot, gardenv1beta1.LastOperationTypeReconcile, gardenv1beta1.LastOperationStateProcessing, start)
+		succeededReconcile  = withLastOperation(shoot, gardenv1beta1.LastOperationTypeReconcile, gardenv1beta1.LastOperationStateSucceeded, start.Add(5*time.Minute))
+		processingDelete    = withLastOperation(shoot, gardenv1beta1.LastOperationTypeDelete, gardenv1beta1.LastOperationStateProcessing, start.Add(10*time.Minute))
+	)
+
+	handlers.OnUpdate(succeededCreate, processingReconcile)
+	handlers.OnUpdate(processingReconcile, succeededReconcile)
+	handlers.OnUpdate(succeededReconcile, processingDelete)
+	// The delete operation succeeded once the Shoot is gone.
+	handlers.OnDelete(processingDelete)
+
+	assertValues(t, collectValues(shootOperationDuration), map[string]float64{
+		"iaas=aws,operation=Reconcile,seed=aws-eu1": 1,
+		"iaas=aws,operation=Delete,seed=aws-eu1":    1,
+	})
+}
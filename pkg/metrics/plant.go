@@ -15,6 +15,10 @@
 package metrics
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apimachinery/pkg/labels"
 )
@@ -32,6 +36,7 @@ func (c gardenMetricsCollector) collectPlantMetrics(ch chan<- prometheus.Metric)
 		return
 	}
 
+	plantCounters := make(map[string]float64)
 	for _, plant := range plants {
 		var (
 			k8sVersion = "unknown"
@@ -57,6 +62,9 @@ func (c gardenMetricsCollector) collectPlantMetrics(ch chan<- prometheus.Metric)
 			}
 		}
 
+		// Count the Plants per provider and health state.
+		plantCounters[fmt.Sprintf("%s:%s", provider, strconv.FormatBool(conditionsHealthy(plant.Status.Conditions)))]++
+
 		metric, err := prometheus.NewConstMetric(c.descs[metricGardenPlantInfo], prometheus.GaugeValue, 0, plant.ObjectMeta.Name, *projectName, provider, region, k8sVersion)
 		if err != nil {
 			ScrapeFailures.With(prometheus.Labels{"kind": "plants"}).Inc()
@@ -74,4 +82,14 @@ func (c gardenMetricsCollector) collectPlantMetrics(ch chan<- prometheus.Metric)
 			ch <- metric
 		}
 	}
+
+	for plantInfos, count := range plantCounters {
+		labels := strings.Split(plantInfos, ":")
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenPlantsTotal], prometheus.GaugeValue, count, labels...)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "plants"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
 }
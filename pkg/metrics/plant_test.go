@@ -0,0 +1,52 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+func newTestPlant(name, provider string, status gardenv1beta1.ConditionStatus) *gardenv1beta1.Plant {
+	plant := &gardenv1beta1.Plant{}
+	plant.Namespace, plant.Name = "garden-dev", name
+	if provider != "" {
+		plant.Status.ClusterInfo = &gardenv1beta1.ClusterInfo{}
+		plant.Status.ClusterInfo.Cloud.Type = provider
+	}
+	plant.Status.Conditions = []gardenv1beta1.Condition{
+		{Type: gardenv1beta1.PlantAPIServerAvailable, Status: gardenv1beta1.ConditionTrue},
+		{Type: gardenv1beta1.PlantEveryNodeReady, Status: status},
+	}
+	return plant
+}
+
+func TestCollectPlantsTotalMetrics(t *testing.T) {
+	c := newTestCollector(t,
+		newTestProject("dev", "garden-dev"),
+		newTestPlant("aws-1", "aws", gardenv1beta1.ConditionTrue),
+		newTestPlant("aws-2", "aws", gardenv1beta1.ConditionTrue),
+		newTestPlant("aws-3", "aws", gardenv1beta1.ConditionFalse),
+		newTestPlant("unknown", "", gardenv1beta1.ConditionTrue),
+	)
+
+	collected := collectSeries(c.collectPlantMetrics)
+	assertValues(t, collected[c.descs[metricGardenPlantsTotal]], map[string]float64{
+		"healthy=true,provider=aws":     2,
+		"healthy=false,provider=aws":    1,
+		"healthy=true,provider=unknown": 1,
+	})
+}
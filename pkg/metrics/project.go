@@ -19,6 +19,7 @@ import (
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
@@ -60,6 +61,37 @@ func (c gardenMetricsCollector) collectProjectMetrics(ch chan<- prometheus.Metri
 		ch <- metric
 	}
 
+	for _, project := range projects {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenProjectCreation], prometheus.GaugeValue, float64(project.CreationTimestamp.Unix()), project.Name)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "projects-status"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		// Count the members of the project per role. Members with multiple roles are counted for each of them.
+		memberRoles := make(map[string]float64)
+		for _, member := range project.Spec.Members {
+			roles := map[string]bool{member.Role: true}
+			for _, role := range member.Roles {
+				roles[role] = true
+			}
+			for role := range roles {
+				if role != "" {
+					memberRoles[role]++
+				}
+			}
+		}
+		for role, count := range memberRoles {
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenProjectMembers], prometheus.GaugeValue, count, project.Name, role)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "projects-members"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+	}
+
 	// Determine user counts.
 	var (
 		metric prometheus.Metric
@@ -118,3 +150,40 @@ func (c gardenMetricsCollector) collectProjectMetrics(ch chan<- prometheus.Metri
 	}
 	ch <- metric
 }
+
+// collectProjectResourceQuotaMetrics exposes the hard limits and the usage of the ResourceQuotas in the project namespaces,
+// e.g. for the count of Shoots. ResourceQuotas in namespaces which do not belong to a project are skipped.
+func (c gardenMetricsCollector) collectProjectResourceQuotaMetrics(ch chan<- prometheus.Metric) {
+	projects, err := c.projectInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "projects-count"}).Inc()
+		return
+	}
+
+	for _, project := range projects {
+		if project.Spec.Namespace == nil {
+			continue
+		}
+		resourceQuotas, err := c.resourceQuotaInformer.Lister().ResourceQuotas(*project.Spec.Namespace).List(labels.Everything())
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "resourcequotas"}).Inc()
+			continue
+		}
+
+		for _, resourceQuota := range resourceQuotas {
+			for metricName, resources := range map[string]corev1.ResourceList{
+				metricGardenProjectQuotaHard: resourceQuota.Status.Hard,
+				metricGardenProjectQuotaUsed: resourceQuota.Status.Used,
+			} {
+				for resourceName, quantity := range resources {
+					metric, err := prometheus.NewConstMetric(c.descs[metricName], prometheus.GaugeValue, float64(quantity.MilliValue())/1000, project.Name, resourceQuota.Name, string(resourceName))
+					if err != nil {
+						ScrapeFailures.With(prometheus.Labels{"kind": "resourcequotas"}).Inc()
+						continue
+					}
+					ch <- metric
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const quotaMetricLoadbalancer corev1.ResourceName = "loadbalancer"
+
+// quotaUsage holds the resources which are allocated in a namespace for a Quota.
+type quotaUsage struct {
+	quota     *gardenv1beta1.Quota
+	namespace string
+	used      corev1.ResourceList
+}
+
+// collectQuotaMetrics collects Quota metrics.
+func (c gardenMetricsCollector) collectQuotaMetrics(ch chan<- prometheus.Metric) {
+	quotas, err := c.quotaInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "quotas"}).Inc()
+		return
+	}
+	c.collectQuotaLimitMetrics(quotas, ch)
+
+	secretBindings, err := c.secretBindingInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "secretbindings"}).Inc()
+		return
+	}
+	cloudProfiles, err := c.cloudProfileInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "cloudprofiles"}).Inc()
+		return
+	}
+	projects, err := c.projectInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "projects-count"}).Inc()
+		return
+	}
+
+	var (
+		quotasByKey         = make(map[string]*gardenv1beta1.Quota, len(quotas))
+		cloudProfilesByName = make(map[string]*gardenv1beta1.CloudProfile, len(cloudProfiles))
+		usages              = make(map[string]*quotaUsage)
+		quotaExceeded       = make(map[string]float64)
+	)
+	for _, quota := range quotas {
+		quotasByKey[fmt.Sprintf("%s/%s", quota.Namespace, quota.Name)] = quota
+	}
+	for _, cloudProfile := range cloudProfiles {
+		cloudProfilesByName[cloudProfile.Name] = cloudProfile
+	}
+
+	// Determine the resources which are allocated by the Shoots of a project for
+	// each project scoped Quota. The Shoots refer to the Quota via their SecretBinding.
+	for _, secretBinding := range secretBindings {
+		shoots, err := c.shootInformer.Lister().Shoots(secretBinding.Namespace).List(labels.Everything())
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			return
+		}
+
+		for _, quotaRef := range secretBinding.Quotas {
+			quotaKey := fmt.Sprintf("%s/%s", quotaRef.Namespace, quotaRef.Name)
+			quota, ok := quotasByKey[quotaKey]
+			if !ok || quota.Spec.Scope.Kind != "Project" {
+				continue
+			}
+
+			usageKey := fmt.Sprintf("%s:%s", quotaKey, secretBinding.Namespace)
+			usage, ok := usages[usageKey]
+			if !ok {
+				usage = &quotaUsage{quota: quota, namespace: secretBinding.Namespace, used: corev1.ResourceList{}}
+				usages[usageKey] = usage
+			}
+			for _, shoot := range shoots {
+				if shoot.Spec.SecretBindingName == secretBinding.Name {
+					addShootResources(usage.used, shoot, cloudProfilesByName[shoot.Spec.CloudProfileName])
+				}
+			}
+		}
+	}
+
+	// Compare the allocated resources with the Quota limits. A resource of a project
+	// is considered as exceeded if at least one of the project's Quotas is exceeded.
+	for _, usage := range usages {
+		projectName, err := findProject(projects, usage.namespace)
+		if err != nil {
+			c.logger.Error(err.Error())
+			continue
+		}
+		for resourceName, limit := range usage.quota.Spec.Metrics {
+			key := fmt.Sprintf("%s:%s", *projectName, resourceName)
+			if _, ok := quotaExceeded[key]; !ok {
+				quotaExceeded[key] = 0
+			}
+			if used := usage.used[resourceName]; used.Cmp(limit) > 0 {
+				quotaExceeded[key] = 1
+			}
+		}
+	}
+
+	for exceededInfos, exceeded := range quotaExceeded {
+		labels := strings.Split(exceededInfos, ":")
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenProjectQuotaExceeded], prometheus.GaugeValue, exceeded, labels...)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "quotas"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
+
+// collectQuotaLimitMetrics exposes the scope, the cluster lifetime and the resource limits of the Quotas.
+func (c gardenMetricsCollector) collectQuotaLimitMetrics(quotas []*gardenv1beta1.Quota, ch chan<- prometheus.Metric) {
+	for _, quota := range quotas {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenQuotaInfo], prometheus.GaugeValue, 0, quota.Name, quota.Namespace, quota.Spec.Scope.Kind)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "quotas"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		if quota.Spec.ClusterLifetimeDays != nil {
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenQuotaClusterLifetime], prometheus.GaugeValue, float64(*quota.Spec.ClusterLifetimeDays), quota.Name, quota.Namespace)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "quotas"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		for resourceName, limit := range quota.Spec.Metrics {
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenQuotaLimit], prometheus.GaugeValue, float64(limit.MilliValue())/1000, quota.Name, quota.Namespace, string(resourceName))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "quotas"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+	}
+}
+
+// addShootResources adds the resources which are allocated by the Shoot to the given resource list.
+// Like the Gardener quota admission, the maximum size of each worker pool is taken into account.
+func addShootResources(resources corev1.ResourceList, shoot *gardenv1beta1.Shoot, cloudProfile *gardenv1beta1.CloudProfile) {
+	addQuantity(resources, quotaMetricLoadbalancer, *resource.NewQuantity(1, resource.DecimalSI), 1)
+	if cloudProfile == nil {
+		return
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		var machineType *gardenv1beta1.MachineType
+		for i := range cloudProfile.Spec.MachineTypes {
+			if cloudProfile.Spec.MachineTypes[i].Name == worker.Machine.Type {
+				machineType = &cloudProfile.Spec.MachineTypes[i]
+				break
+			}
+		}
+		if machineType == nil {
+			continue
+		}
+		addQuantity(resources, corev1.ResourceCPU, machineType.CPU, worker.Maximum)
+		addQuantity(resources, "gpu", machineType.GPU, worker.Maximum)
+		addQuantity(resources, corev1.ResourceMemory, machineType.Memory, worker.Maximum)
+
+		if worker.Volume == nil {
+			continue
+		}
+		var volumeClass string
+		if machineType.Storage != nil {
+			volumeClass = machineType.Storage.Class
+		} else {
+			for _, volumeType := range cloudProfile.Spec.VolumeTypes {
+				if worker.Volume.Type != nil && volumeType.Name == *worker.Volume.Type {
+					volumeClass = volumeType.Class
+					break
+				}
+			}
+		}
+		volumeSize, err := resource.ParseQuantity(worker.Volume.VolumeSize)
+		if err != nil || volumeClass == "" {
+			continue
+		}
+		addQuantity(resources, corev1.ResourceName(fmt.Sprintf("%s.%s", corev1.ResourceStorage, volumeClass)), volumeSize, worker.Maximum)
+	}
+}
+
+// addQuantity adds the quantity multiplied by the factor to the resource with the given name.
+func addQuantity(resources corev1.ResourceList, name corev1.ResourceName, quantity resource.Quantity, factor int32) {
+	sum := resources[name]
+	sum.Add(*resource.NewMilliQuantity(quantity.MilliValue()*int64(factor), quantity.Format))
+	resources[name] = sum
+}
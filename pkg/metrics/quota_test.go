@@ -0,0 +1,101 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCollectProjectQuotaExceededMetrics(t *testing.T) {
+	cloudProfile := &gardenv1beta1.CloudProfile{}
+	cloudProfile.Name = "aws"
+	cloudProfile.Spec.MachineTypes = []gardenv1beta1.MachineType{
+		{Name: "m5.large", CPU: resource.MustParse("2"), GPU: resource.MustParse("0"), Memory: resource.MustParse("8Gi")},
+	}
+
+	quota := &gardenv1beta1.Quota{}
+	quota.Namespace, quota.Name = "garden-dev", "trial"
+	quota.Spec.Scope = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"}
+	quota.Spec.Metrics = corev1.ResourceList{
+		corev1.ResourceCPU:      resource.MustParse("4"),
+		quotaMetricLoadbalancer: resource.MustParse("2"),
+	}
+
+	secretBinding := &gardenv1beta1.SecretBinding{}
+	secretBinding.Namespace, secretBinding.Name = "garden-dev", "aws"
+	secretBinding.Quotas = []corev1.ObjectReference{{Namespace: "garden-dev", Name: "trial"}}
+
+	objects := []runtime.Object{newTestProject("dev", "garden-dev"), cloudProfile, quota, secretBinding}
+	for _, name := range []string{"foo", "bar"} {
+		shoot := newTestShoot("garden-dev", name, "uid-"+name)
+		shoot.Spec.CloudProfileName = "aws"
+		shoot.Spec.SecretBindingName = "aws"
+		shoot.Spec.Provider.Workers = []gardenv1beta1.Worker{{
+			Name:    "pool-a",
+			Machine: gardenv1beta1.Machine{Type: "m5.large"},
+			Maximum: 2,
+		}}
+		objects = append(objects, shoot)
+	}
+	// Shoots of other SecretBindings do not allocate resources of the Quota.
+	other := newTestShoot("garden-dev", "other", "uid-other")
+	other.Spec.SecretBindingName = "gcp"
+	objects = append(objects, other)
+
+	c := newTestCollector(t, objects...)
+	collected := collectSeries(c.collectQuotaMetrics)
+
+	// Two Shoots with two machines with 2 CPUs each allocate 8 CPUs and 2 load balancers.
+	assertValues(t, collected[c.descs[metricGardenProjectQuotaExceeded]], map[string]float64{
+		"project=dev,resource=cpu":          1,
+		"project=dev,resource=loadbalancer": 0,
+	})
+}
+
+func TestAddShootResources(t *testing.T) {
+	cloudProfile := &gardenv1beta1.CloudProfile{}
+	cloudProfile.Spec.MachineTypes = []gardenv1beta1.MachineType{
+		{Name: "m5.large", CPU: resource.MustParse("2"), GPU: resource.MustParse("0"), Memory: resource.MustParse("8Gi")},
+	}
+	cloudProfile.Spec.VolumeTypes = []gardenv1beta1.VolumeType{{Name: "gp2", Class: "standard"}}
+
+	volumeType := "gp2"
+	shoot := newTestShoot("garden-dev", "foo", "uid-1")
+	shoot.Spec.Provider.Workers = []gardenv1beta1.Worker{
+		{Name: "pool-a", Machine: gardenv1beta1.Machine{Type: "m5.large"}, Maximum: 3, Volume: &gardenv1beta1.Volume{Type: &volumeType, VolumeSize: "50Gi"}},
+		// Machine types which are not contained in the CloudProfile are ignored.
+		{Name: "pool-b", Machine: gardenv1beta1.Machine{Type: "unknown"}, Maximum: 3},
+	}
+
+	resources := corev1.ResourceList{}
+	addShootResources(resources, shoot, cloudProfile)
+
+	for name, want := range map[corev1.ResourceName]string{
+		corev1.ResourceCPU:      "6",
+		corev1.ResourceMemory:   "24Gi",
+		quotaMetricLoadbalancer: "1",
+		"storage.standard":      "150Gi",
+	} {
+		got := resources[name]
+		if got.Cmp(resource.MustParse(want)) != 0 {
+			t.Errorf("%s: got %s, want %s", name, got.String(), want)
+		}
+	}
+}
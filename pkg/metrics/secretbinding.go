@@ -0,0 +1,85 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// collectSecretBindingMetrics collects SecretBinding metrics.
+func (c gardenMetricsCollector) collectSecretBindingMetrics(ch chan<- prometheus.Metric) {
+	secretBindings, err := c.secretBindingInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "secretbindings"}).Inc()
+		return
+	}
+	projects, err := c.projectInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "projects-count"}).Inc()
+		return
+	}
+
+	for _, secretBinding := range secretBindings {
+		projectName, err := findProject(projects, secretBinding.Namespace)
+		if err != nil {
+			c.logger.Error(err.Error())
+			continue
+		}
+
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenSecretBindingInfo], prometheus.GaugeValue, 0, secretBinding.Name, *projectName, secretBinding.SecretRef.Namespace, secretBinding.SecretRef.Name)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "secretbindings"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		metric, err = prometheus.NewConstMetric(c.descs[metricGardenSecretBindingCreation], prometheus.GaugeValue, float64(secretBinding.CreationTimestamp.Unix()), secretBinding.Name, *projectName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "secretbindings"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		// Expose the Quotas which limit the Shoots using the SecretBinding.
+		for _, quotaRef := range secretBinding.Quotas {
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenSecretBindingQuota], prometheus.GaugeValue, 0, secretBinding.Name, *projectName, quotaRef.Namespace, quotaRef.Name)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "secretbindings"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Count the Shoots using the SecretBinding, to find unused SecretBindings.
+		shoots, err := c.shootInformer.Lister().Shoots(secretBinding.Namespace).List(labels.Everything())
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		var shootCount float64
+		for _, shoot := range shoots {
+			if shoot.Spec.SecretBindingName == secretBinding.Name {
+				shootCount++
+			}
+		}
+		metric, err = prometheus.NewConstMetric(c.descs[metricGardenSecretBindingShoots], prometheus.GaugeValue, shootCount, secretBinding.Name, *projectName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "secretbindings"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
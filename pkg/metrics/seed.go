@@ -16,6 +16,7 @@ package metrics
 
 import (
 	"strconv"
+	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/prometheus/client_golang/prometheus"
@@ -30,7 +31,16 @@ func (c gardenMetricsCollector) collectSeedMetrics(ch chan<- prometheus.Metric)
 		return
 	}
 
+	now := time.Now()
+	gardenletVersionCounters := make(map[string]float64)
 	for _, seed := range seeds {
+		// Count the Seeds per version of the gardenlet which last acted on them.
+		gardenletVersion := unknown
+		if seed.Status.Gardener != nil && seed.Status.Gardener.Version != "" {
+			gardenletVersion = seed.Status.Gardener.Version
+		}
+		gardenletVersionCounters[gardenletVersion]++
+
 		var (
 			protected bool
 			visible   = true
@@ -50,6 +60,20 @@ func (c gardenMetricsCollector) collectSeedMetrics(ch chan<- prometheus.Metric)
 			continue
 		}
 		ch <- metric
+
+		// Expose if the Seed accepts new Shoots. The scheduler does not consider
+		// Seeds which are invisible or which are in deletion.
+		var accepting float64
+		if visible && seed.DeletionTimestamp == nil {
+			accepting = 1
+		}
+		metric, err = prometheus.NewConstMetric(c.descs[metricGardenSeedAcceptingShoots], prometheus.GaugeValue, accepting, seed.Name)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "seeds"}).Inc()
+			continue
+		}
+		ch <- metric
+
 		// Export a metric for each condition of the Seed.
 		for _, condition := range seed.Status.Conditions {
 			metric, err := prometheus.NewConstMetric(c.descs[metricGardenSeedCondition], prometheus.GaugeValue, mapConditionStatus(condition.Status), seed.Name, string(condition.Type))
@@ -58,6 +82,39 @@ func (c gardenMetricsCollector) collectSeedMetrics(ch chan<- prometheus.Metric)
 				continue
 			}
 			ch <- metric
+
+			if c.conditionStatusSeries {
+				statusMetrics, err := newConditionStatusMetrics(c.descs[metricGardenSeedConditionStatus], condition, seed.Name, string(condition.Type))
+				if err != nil {
+					ScrapeFailures.With(prometheus.Labels{"kind": "seeds"}).Inc()
+					continue
+				}
+				for _, statusMetric := range statusMetrics {
+					ch <- statusMetric
+				}
+			}
+
+			// Expose if the condition was not updated within the stale threshold, which
+			// indicates that the gardenlet stopped to report the status of the Seed.
+			var stale float64
+			if now.Sub(condition.LastUpdateTime.Time) > c.seedConditionStaleThreshold {
+				stale = 1
+			}
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenSeedConditionStale], prometheus.GaugeValue, stale, seed.Name, string(condition.Type))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "seeds"}).Inc()
+				continue
+			}
+			ch <- metric
 		}
 	}
+
+	for version, count := range gardenletVersionCounters {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenSeedsByGardenletVersion], prometheus.GaugeValue, count, version)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "seeds"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
 }
@@ -0,0 +1,90 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCollectSeedAcceptingShootsMetrics(t *testing.T) {
+	var (
+		visible           = &gardenv1beta1.Seed{}
+		invisible         = &gardenv1beta1.Seed{}
+		deleted           = &gardenv1beta1.Seed{}
+		deletionTimestamp = metav1.Now()
+	)
+	visible.Name = "aws-eu1"
+	invisible.Name = "aws-eu2"
+	invisible.Spec.Taints = []gardenv1beta1.SeedTaint{{Key: gardenv1beta1.SeedTaintInvisible}}
+	deleted.Name = "aws-eu3"
+	deleted.DeletionTimestamp = &deletionTimestamp
+
+	c := newTestCollector(t, visible, invisible, deleted)
+	collected := collectSeries(c.collectSeedMetrics)
+
+	assertValues(t, collected[c.descs[metricGardenSeedAcceptingShoots]], map[string]float64{
+		"name=aws-eu1": 1,
+		"name=aws-eu2": 0,
+		"name=aws-eu3": 0,
+	})
+}
+
+func TestCollectSeedsByGardenletVersionMetrics(t *testing.T) {
+	newSeed := func(name, version string) *gardenv1beta1.Seed {
+		seed := &gardenv1beta1.Seed{}
+		seed.Name = name
+		if version != "" {
+			seed.Status.Gardener = &gardenv1beta1.Gardener{Version: version}
+		}
+		return seed
+	}
+
+	c := newTestCollector(t,
+		newSeed("aws-eu1", "v1.4.0"),
+		newSeed("aws-eu2", "v1.4.0"),
+		newSeed("gcp-eu1", "v1.5.0"),
+		// Seeds which have not been reconciled by a gardenlet yet.
+		newSeed("azure-eu1", ""),
+	)
+	collected := collectSeries(c.collectSeedMetrics)
+
+	assertValues(t, collected[c.descs[metricGardenSeedsByGardenletVersion]], map[string]float64{
+		"version=v1.4.0":  2,
+		"version=v1.5.0":  1,
+		"version=unknown": 1,
+	})
+}
+
+func TestCollectSeedConditionStaleMetrics(t *testing.T) {
+	seed := &gardenv1beta1.Seed{}
+	seed.Name = "aws-eu1"
+	seed.Status.Conditions = []gardenv1beta1.Condition{
+		{Type: gardenv1beta1.SeedGardenletReady, Status: gardenv1beta1.ConditionTrue, LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Minute))},
+		{Type: gardenv1beta1.SeedBootstrapped, Status: gardenv1beta1.ConditionTrue, LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Hour))},
+	}
+
+	c := newTestCollector(t, seed)
+	c.seedConditionStaleThreshold = 10 * time.Minute
+
+	collected := collectSeries(c.collectSeedMetrics)
+	assertValues(t, collected[c.descs[metricGardenSeedConditionStale]], map[string]float64{
+		"condition=GardenletReady,name=aws-eu1": 0,
+		"condition=Bootstrapped,name=aws-eu1":   1,
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// shootSeriesTracker remembers the label values of the per Shoot series of the counters which are
+// observed from Shoot changes. This allows to delete the series of a deleted Shoot with the same
+// label values, even if e.g. its project cannot be determined anymore.
+type shootSeriesTracker struct {
+	sync.Mutex
+	series map[types.UID]map[shootSeriesKey][]string
+}
+
+type shootSeriesKey struct {
+	counter *prometheus.CounterVec
+	values  string
+}
+
+func newShootSeriesTracker() *shootSeriesTracker {
+	return &shootSeriesTracker{series: make(map[types.UID]map[shootSeriesKey][]string)}
+}
+
+// inc increments the series of the counter with the given label values and records it for the Shoot.
+func (t *shootSeriesTracker) inc(uid types.UID, counter *prometheus.CounterVec, values ...string) {
+	t.Lock()
+	defer t.Unlock()
+
+	counter.WithLabelValues(values...).Inc()
+	if _, ok := t.series[uid]; !ok {
+		t.series[uid] = make(map[shootSeriesKey][]string)
+	}
+	t.series[uid][shootSeriesKey{counter: counter, values: strings.Join(values, "\xff")}] = values
+}
+
+// forget deletes all recorded series of the Shoot.
+func (t *shootSeriesTracker) forget(uid types.UID) {
+	t.Lock()
+	defer t.Unlock()
+
+	for key, values := range t.series[uid] {
+		key.counter.DeleteLabelValues(values...)
+	}
+	delete(t.series, uid)
+}
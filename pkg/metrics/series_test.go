@@ -0,0 +1,50 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestShootSeriesTracker(t *testing.T) {
+	var (
+		tracker = newShootSeriesTracker()
+		counter = prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_transitions_total"}, []string{"name", "project", "transition"})
+	)
+
+	tracker.inc("uid-1", counter, "foo", "dev", hibernationTransitionHibernate)
+	tracker.inc("uid-1", counter, "foo", "dev", hibernationTransitionHibernate)
+	// The project cannot be determined anymore, e.g. because it is already deleted.
+	tracker.inc("uid-1", counter, "foo", unknown, hibernationTransitionWakeUp)
+	tracker.inc("uid-2", counter, "bar", "dev", hibernationTransitionWakeUp)
+
+	want := map[string]float64{
+		"name=foo,project=dev,transition=hibernate":   2,
+		"name=foo,project=unknown,transition=wake_up": 1,
+		"name=bar,project=dev,transition=wake_up":     1,
+	}
+	assertValues(t, collectValues(counter), want)
+
+	tracker.forget("uid-1")
+	assertValues(t, collectValues(counter), map[string]float64{
+		"name=bar,project=dev,transition=wake_up": 1,
+	})
+
+	tracker.forget("uid-2")
+	tracker.forget("uid-3")
+	assertValues(t, collectValues(counter), map[string]float64{})
+}
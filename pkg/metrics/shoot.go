@@ -16,14 +16,20 @@ package metrics
 
 import (
 	"fmt"
+	"hash/fnv"
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/robfig/cron"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 var (
@@ -34,6 +40,21 @@ var (
 		string(gardenv1beta1.LastOperationTypeReconcile),
 		string(gardenv1beta1.LastOperationTypeDelete),
 	}
+
+	// shootAgeBuckets are the upper bounds of the Shoot age histogram in seconds,
+	// ranging from one hour to three years.
+	shootAgeBuckets = []float64{
+		time.Hour.Seconds(),
+		(6 * time.Hour).Seconds(),
+		(24 * time.Hour).Seconds(),
+		(7 * 24 * time.Hour).Seconds(),
+		(30 * 24 * time.Hour).Seconds(),
+		(90 * 24 * time.Hour).Seconds(),
+		(180 * 24 * time.Hour).Seconds(),
+		(365 * 24 * time.Hour).Seconds(),
+		(2 * 365 * 24 * time.Hour).Seconds(),
+		(3 * 365 * 24 * time.Hour).Seconds(),
+	}
 )
 
 func init() {
@@ -48,6 +69,8 @@ func init() {
 func (c gardenMetricsCollector) collectShootMetrics(ch chan<- prometheus.Metric) {
 	var (
 		shootOperationsCounters = make(map[string]float64)
+		projectPurposeCounters  = make(map[string]float64)
+		purposeCounters         = make(map[string]float64)
 	)
 
 	// Fetch all Shoots.
@@ -56,6 +79,7 @@ func (c gardenMetricsCollector) collectShootMetrics(ch chan<- prometheus.Metric)
 		ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
 		return
 	}
+	shoots = c.filterShard(shoots)
 
 	projects, err := c.projectInformer.Lister().List(labels.Everything())
 	if err != nil {
@@ -63,11 +87,37 @@ func (c gardenMetricsCollector) collectShootMetrics(ch chan<- prometheus.Metric)
 		return
 	}
 
+	cloudProfiles, err := c.cloudProfileInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "cloudprofiles"}).Inc()
+		return
+	}
+	cloudProfilesByName := make(map[string]*gardenv1beta1.CloudProfile, len(cloudProfiles))
+	for _, cloudProfile := range cloudProfiles {
+		cloudProfilesByName[cloudProfile.Name] = cloudProfile
+	}
+
+	controllerRegistrations, err := c.controllerRegistrationInformer.Lister().List(labels.Everything())
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "controllerregistrations"}).Inc()
+		return
+	}
+	globalExtensions := globallyEnabledExtensions(controllerRegistrations)
+
 	collectShootCustomizationMetrics(shoots, ch)
 
+	now := time.Now()
+	c.collectShootAgeMetrics(shoots, now, ch)
+
 	for _, shoot := range shoots {
 		// Some Shoot sanity checks.
-		if shoot == nil || shoot.Spec.SeedName == nil {
+		if shoot == nil {
+			continue
+		}
+
+		// Shoots which are not scheduled to a Seed yet are only exposed as unscheduled.
+		if shoot.Spec.SeedName == nil {
+			c.collectShootUnscheduledMetrics(shoot, projects, ch)
 			continue
 		}
 
@@ -83,6 +133,10 @@ func (c gardenMetricsCollector) collectShootMetrics(ch chan<- prometheus.Metric)
 		if shoot.Spec.Purpose != nil {
 			purpose = string(*shoot.Spec.Purpose)
 		}
+		purposeLabel := purpose
+		if purposeLabel == "" {
+			purposeLabel = unknown
+		}
 
 		projectName, err := findProject(projects, shoot.Namespace)
 		if err != nil {
@@ -90,6 +144,25 @@ func (c gardenMetricsCollector) collectShootMetrics(ch chan<- prometheus.Metric)
 			continue
 		}
 
+		// Count the Shoots per purpose in the project and in total.
+		projectPurposeCounters[fmt.Sprintf("%s:%s", *projectName, purposeLabel)]++
+		purposeCounters[purposeLabel]++
+
+		// Collect the current count of ongoing operations.
+		if shoot.Status.LastOperation != nil && !isSeed {
+			shootOperationsCounters[fmt.Sprintf("%s:%s:%s:%s:%s:%s", shoot.Status.LastOperation.Type, shoot.Status.LastOperation.State, iaas, seed, shoot.Spec.Kubernetes.Version, shoot.Spec.Region)]++
+		}
+
+		// Remember when the Shoot was healthy the last time. This is done for all Shoots
+		// to keep the state up to date, even if the metric is not exposed for the Shoot.
+		lastHealthy, wasHealthy := c.healthTracker.observe(shoot, now)
+
+		// Skip the detailed metrics for Shoots which have not changed within the configured
+		// window. Those Shoots are still considered for the aggregated metrics above.
+		if !c.changedRecently(shoot, now) {
+			continue
+		}
+
 		// Expose a metric, which transport basic information to the Shoot cluster via the metric labels.
 		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, iaas, shoot.Spec.Kubernetes.Version, shoot.Spec.Region, seed, strconv.FormatBool(isSeed))
 		if err != nil {
@@ -98,14 +171,85 @@ func (c gardenMetricsCollector) collectShootMetrics(ch chan<- prometheus.Metric)
 		}
 		ch <- metric
 
-		hibernatedVal := 0
+		// Expose if the control plane of the Shoot is migrated to another Seed. The status contains the
+		// Seed which currently runs the control plane, the specification the Seed it is migrated to.
+		if shoot.Status.SeedName != nil {
+			var migrating float64
+			if *shoot.Status.SeedName != seed {
+				migrating = 1
+			}
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootMigrationInProgress], prometheus.GaugeValue, migrating, shoot.Name, *projectName, *shoot.Status.SeedName, seed)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
 
-		if shoot.Status.IsHibernated {
-			hibernatedVal = 1
+		// Expose the Kubernetes version of the Shoot as comparable number.
+		if major, minor, patch, err := parseKubernetesVersion(shoot.Spec.Kubernetes.Version); err != nil {
+			c.logger.Errorf("invalid Kubernetes version %s of Shoot %s/%s: %s", shoot.Spec.Kubernetes.Version, shoot.Namespace, shoot.Name, err.Error())
+		} else {
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootKubernetesVersion], prometheus.GaugeValue, float64(major*10000+minor*100+patch), shoot.Name, *projectName, strconv.Itoa(major), strconv.Itoa(minor))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
 		}
 
-		metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootHibernated], prometheus.GaugeValue, float64(hibernatedVal), shoot.Name, *projectName, string(shoot.UID))
+		// Expose the purpose of the Shoot.
+		metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootPurpose], prometheus.GaugeValue, 0, shoot.Name, *projectName, purposeLabel)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		// Expose the hibernation, operation state and condition metrics of the Shoot.
+		for _, value := range shootStatusValues(shoot, *projectName) {
+			metric, err = prometheus.NewConstMetric(c.descs[value.metric], prometheus.GaugeValue, value.value, value.labels...)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
 
+		// Expose if the Shoot has no worker pools.
+		var workerless float64
+		if len(shoot.Spec.Provider.Workers) == 0 {
+			workerless = 1
+		}
+		metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootWorkerless], prometheus.GaugeValue, workerless, shoot.Name, *projectName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		// Expose if the Shoot is annotated to be ignored by the gardenlet.
+		var ignored float64
+		if annotationEnabled(shoot.Annotations, annotationShootIgnore, annotationShootIgnoreDeprecated) {
+			ignored = 1
+		}
+		metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootIgnored], prometheus.GaugeValue, ignored, shoot.Name, *projectName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		// Expose if the deletion of the Shoot is confirmed.
+		var deletionConfirmed float64
+		if annotationEnabled(shoot.Annotations, annotationConfirmationDeletion, annotationConfirmationDeletionDeprecated) {
+			deletionConfirmed = 1
+		}
+		metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootDeletionConfirmed], prometheus.GaugeValue, deletionConfirmed, shoot.Name, *projectName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
 		ch <- metric
 
 		shootCreation := shoot.CreationTimestamp
@@ -113,45 +257,180 @@ func (c gardenMetricsCollector) collectShootMetrics(ch chan<- prometheus.Metric)
 
 		ch <- metric
 
+		// Expose the deletion timestamp for Shoots which are in deletion.
+		if shoot.DeletionTimestamp != nil {
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootDeletion], prometheus.GaugeValue, float64(shoot.DeletionTimestamp.Unix()), shoot.Name, *projectName, string(shoot.UID))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Expose the CloudProfile referenced by the Shoot.
+		metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootCloudProfileInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, shoot.Spec.CloudProfileName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+
+		// Expose the technical id of the Shoot to correlate it with logs and metrics from the Seed.
+		if shoot.Status.TechnicalID != "" {
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootClusterIdentityInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, shoot.Status.TechnicalID)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Expose the environment of the Shoot, which is propagated from its project namespace.
+		c.collectShootEnvironmentMetrics(shoot, projectName, ch)
+
+		// Expose the maintenance time window of the Shoot. The begin and end contain the time zone offset, e.g. 220000+0100.
+		if shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.TimeWindow != nil {
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootMaintenanceWindowInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, shoot.Spec.Maintenance.TimeWindow.Begin, shoot.Spec.Maintenance.TimeWindow.End)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Expose the auto update settings of the Shoot.
+		if shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.AutoUpdate != nil {
+			for updateType, enabled := range map[string]bool{
+				"kubernetes_version":    shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion,
+				"machine_image_version": shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion,
+			} {
+				var value float64
+				if enabled {
+					value = 1
+				}
+				metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootAutoUpdateEnabled], prometheus.GaugeValue, value, shoot.Name, *projectName, updateType)
+				if err != nil {
+					ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+					continue
+				}
+				ch <- metric
+			}
+		}
+
+		// Expose the next activations of the hibernation schedules of the Shoot.
+		c.collectShootHibernationScheduleMetrics(shoot, projectName, now, ch)
+
+		// Expose the domain and the primary DNS provider of the Shoot.
+		if shoot.Spec.DNS != nil {
+			var (
+				domain       = unknown
+				providerType = unknown
+			)
+			if shoot.Spec.DNS.Domain != nil {
+				domain = *shoot.Spec.DNS.Domain
+			}
+			if provider := findPrimaryDNSProvider(shoot.Spec.DNS.Providers); provider != nil && provider.Type != nil {
+				providerType = *provider.Type
+			}
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootDNSInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, domain, providerType)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Expose the networking configuration of the Shoot.
+		c.collectShootNetworkingMetrics(shoot, projectName, ch)
+
+		// Expose the extensions which are enabled for the Shoot.
+		c.collectShootExtensionMetrics(shoot, projectName, globalExtensions, ch)
+
+		// Expose which addons are enabled for the Shoot.
+		c.collectShootAddonMetrics(shoot, projectName, ch)
+
+		// Expose the alerting email receivers of the Shoot.
+		if shoot.Spec.Monitoring != nil && shoot.Spec.Monitoring.Alerting != nil {
+			for _, receiver := range shoot.Spec.Monitoring.Alerting.EmailReceivers {
+				metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootAlertReceiverInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, receiver)
+				if err != nil {
+					ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+					continue
+				}
+				ch <- metric
+			}
+		}
+
+		if wasHealthy {
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootLastHealthyTimestamp], prometheus.GaugeValue, float64(lastHealthy.Unix()), shoot.Name, *projectName)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Expose the weighted health score of the Shoot.
+		if score, ok := healthScore(shoot, c.healthScoreWeights); ok {
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootHealthScore], prometheus.GaugeValue, score, shoot.Name, *projectName)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Expose the error codes of the last errors of the Shoot.
+		c.collectShootLastErrorMetrics(shoot, projectName, ch)
+
 		// Collect metrics to the node count of the Shoot.
 		// TODO: Use the metrics of the Machine-Controller-Manager, when available. The mcm should be able to provide the actual amount of nodes/machines.
 		c.collectShootNodeMetrics(shoot, projectName, ch)
 
+		// Collect metrics to the configuration of the worker pools of the Shoot.
+		c.collectShootWorkerMetrics(shoot, projectName, ch)
+
+		// Expose if the backup of the Shoot is healthy.
+		c.collectShootBackupMetrics(shoot, projectName, ch)
+
+		// Collect metrics to the kube apiserver configuration of the Shoot.
+		c.collectShootKubeAPIServerMetrics(shoot, projectName, ch)
+
+		// Collect metrics to the kube controller manager configuration of the Shoot.
+		c.collectShootKubeControllerManagerMetrics(shoot, projectName, ch)
+
+		// Collect metrics to the cluster autoscaler configuration of the Shoot.
+		c.collectShootClusterAutoscalerMetrics(shoot, projectName, ch)
+
+		// Expose the feature gates configured for the control plane and node components of the Shoot.
+		c.collectShootFeatureGateMetrics(shoot, projectName, ch)
+
+		// Expose if the Kubernetes version of the Shoot expires soon, which will result in a forced upgrade.
+		c.collectShootForcedUpgradeMetrics(shoot, projectName, cloudProfilesByName[shoot.Spec.CloudProfileName], now, ch)
+
 		// collectShootCustomizationMetrics(shoot, projectName, ch)
 
+		// Export a metric for each constraint of the Shoot.
+		for _, constraint := range shoot.Status.Constraints {
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootConstraint], prometheus.GaugeValue, mapConditionStatus(constraint.Status), shoot.Name, *projectName, string(constraint.Type))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
 		if shoot.Status.LastOperation != nil {
 			lastOperation := string(shoot.Status.LastOperation.Type)
-			lastOperationState := string(shoot.Status.LastOperation.State)
 
-			// Export a metric for any possible operation, which can be ongoing on the Shoot.
+			// Export the progress for any possible operation, which can be ongoing on the Shoot.
 			// For currently non ongoing operations the value of the metric not will be set to 0.
 			for _, operation := range shootOperations {
-				var operationState float64
 				var operationProgress float64
 				if operation == lastOperation {
-					switch shoot.Status.LastOperation.State {
-					case gardenv1beta1.LastOperationStateSucceeded:
-						operationState = 1
-					case gardenv1beta1.LastOperationStateProcessing:
-						operationState = 2
-					case gardenv1beta1.LastOperationStatePending:
-						operationState = 3
-					case gardenv1beta1.LastOperationStateAborted:
-						operationState = 4
-					case gardenv1beta1.LastOperationStateError:
-						operationState = 5
-					case gardenv1beta1.LastOperationStateFailed:
-						operationState = 6
-					}
 					operationProgress = float64(shoot.Status.LastOperation.Progress)
 				}
-				metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootOperationState], prometheus.GaugeValue, operationState, shoot.Name, *projectName, operation)
-				if err != nil {
-					ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
-					continue
-				}
-				ch <- metric
-				metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootOperationProgressPercent], prometheus.GaugeValue, operationProgress, shoot.Name, *projectName, operation)
+				metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootOperationProgressPercent], prometheus.GaugeValue, operationProgress, shoot.Name, *projectName, operation)
 				if err != nil {
 					ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
 					continue
@@ -159,15 +438,53 @@ func (c gardenMetricsCollector) collectShootMetrics(ch chan<- prometheus.Metric)
 				ch <- metric
 			}
 
-			// Export a metric for each condition of the Shoot.
+			// Expose when the last operation was updated and how long ago this was, to detect operations which do not progress.
+			lastOperationLabels := []string{shoot.Name, *projectName, lastOperation, string(shoot.Status.LastOperation.State)}
+			lastUpdateTime := shoot.Status.LastOperation.LastUpdateTime.Time
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootLastOperationLastUpdate], prometheus.GaugeValue, float64(lastUpdateTime.Unix()), lastOperationLabels...)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+			metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootLastOperationSinceUpdate], prometheus.GaugeValue, now.Sub(lastUpdateTime).Seconds(), lastOperationLabels...)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+
+			// Export further metrics for each condition of the Shoot.
 			for _, condition := range shoot.Status.Conditions {
-				metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootCondition], prometheus.GaugeValue, mapConditionStatus(condition.Status), shoot.Name, *projectName, string(condition.Type), lastOperation, purpose, strconv.FormatBool(isSeed), iaas)
+				if c.conditionStatusSeries {
+					statusMetrics, err := newConditionStatusMetrics(c.descs[metricGardenShootConditionStatus], condition, shoot.Name, *projectName, string(condition.Type))
+					if err != nil {
+						ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+						continue
+					}
+					for _, statusMetric := range statusMetrics {
+						ch <- statusMetric
+					}
+				}
+
+				// Expose when the condition changed its status the last time, to detect conditions which are unhealthy for long.
+				metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootConditionTransition], prometheus.GaugeValue, float64(condition.LastTransitionTime.Unix()), shoot.Name, *projectName, string(condition.Type))
 				if err != nil {
 					ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
 					continue
 				}
 				ch <- metric
 
+				// Expose the error codes of the condition, which allow to distinguish user caused from infrastructure caused issues.
+				for _, code := range condition.Codes {
+					metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootConditionCode], prometheus.GaugeValue, 1, shoot.Name, *projectName, string(condition.Type), string(code))
+					if err != nil {
+						ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+						continue
+					}
+					ch <- metric
+				}
+
 				// Handle the ShootAPIServerAvailable condition special. This condition can transport a measured
 				// response time of a request to the API Server. This information will be extracted if available
 				// and exposed in a seperate metric.
@@ -175,15 +492,71 @@ func (c gardenMetricsCollector) collectShootMetrics(ch chan<- prometheus.Metric)
 					c.exposeAPIServerResponseTime(condition, shoot, projectName, ch)
 				}
 			}
-
-			// Collect the current count of ongoing operations.
-			if !isSeed {
-				shootOperationsCounters[fmt.Sprintf("%s:%s:%s:%s:%s:%s", lastOperation, lastOperationState, iaas, seed, shoot.Spec.Kubernetes.Version, shoot.Spec.Region)]++
-			}
 		}
 	}
 
 	c.exposeShootOperations(shootOperationsCounters, ch)
+	c.exposeProjectShootPurposes(projectPurposeCounters, ch)
+	c.exposeShootPurposes(purposeCounters, ch)
+}
+
+// shootStatusValue is the value of a series of a per Shoot metric together with the reason for the value.
+type shootStatusValue struct {
+	metric string
+	labels []string
+	value  float64
+	reason string
+}
+
+// shootStatusValues returns the values of the hibernation, operation state and condition metrics of the Shoot.
+// They are exposed by the collector and explained by the explain handler.
+func shootStatusValues(shoot *gardenv1beta1.Shoot, projectName string) []shootStatusValue {
+	var hibernated float64
+	if shoot.Status.IsHibernated {
+		hibernated = 1
+	}
+	values := []shootStatusValue{{
+		metric: metricGardenShootHibernated,
+		labels: []string{shoot.Name, projectName, string(shoot.UID)},
+		value:  hibernated,
+		reason: fmt.Sprintf("status.hibernated is %t", shoot.Status.IsHibernated),
+	}}
+
+	// The operation state and condition metrics are only exposed for Shoots with a last operation.
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation == nil {
+		return values
+	}
+
+	// Export a metric for any possible operation, which can be ongoing on the Shoot.
+	// For currently non ongoing operations the value of the metric not will be set to 0.
+	for _, operation := range shootOperations {
+		value := shootStatusValue{
+			metric: metricGardenShootOperationState,
+			labels: []string{shoot.Name, projectName, operation},
+			reason: fmt.Sprintf("last operation is %s", lastOperation.Type),
+		}
+		if operation == string(lastOperation.Type) {
+			value.value = mapOperationState(lastOperation.State)
+			value.reason = fmt.Sprintf("last operation %s is in state %s: %s", lastOperation.Type, lastOperation.State, lastOperation.Description)
+		}
+		values = append(values, value)
+	}
+
+	// Export a metric for each condition of the Shoot.
+	var purpose string
+	if shoot.Spec.Purpose != nil {
+		purpose = string(*shoot.Spec.Purpose)
+	}
+	for _, condition := range shoot.Status.Conditions {
+		values = append(values, shootStatusValue{
+			metric: metricGardenShootCondition,
+			labels: []string{shoot.Name, projectName, string(condition.Type), string(lastOperation.Type), purpose, strconv.FormatBool(usedAsSeed(shoot)), shoot.Spec.Provider.Type},
+			value:  mapConditionStatus(condition.Status),
+			reason: fmt.Sprintf("condition %s has status %s (reason: %s): %s", condition.Type, condition.Status, condition.Reason, condition.Message),
+		})
+	}
+	return values
 }
 
 func (c gardenMetricsCollector) collectShootNodeMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
@@ -215,6 +588,629 @@ func (c gardenMetricsCollector) collectShootNodeMetrics(shoot *gardenv1beta1.Sho
 	ch <- metric
 }
 
+func (c gardenMetricsCollector) collectShootWorkerMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	for _, worker := range shoot.Spec.Provider.Workers {
+		// Expose the scaling limits of the worker pool.
+		workerPoolValues := map[string]float64{
+			metricGardenShootWorkerPoolNodeMin:   float64(worker.Minimum),
+			metricGardenShootWorkerPoolNodeMax:   float64(worker.Maximum),
+			metricGardenShootWorkerPoolZoneCount: float64(len(worker.Zones)),
+		}
+		if worker.MaxSurge != nil {
+			// A percentage is relative to the maximum size of the worker pool.
+			maxSurge, err := intstr.GetValueFromIntOrPercent(worker.MaxSurge, int(worker.Maximum), true)
+			if err != nil {
+				c.logger.Errorf("invalid max surge %s in worker pool %s of Shoot %s/%s: %s", worker.MaxSurge.String(), worker.Name, shoot.Namespace, shoot.Name, err.Error())
+			} else {
+				workerPoolValues[metricGardenShootWorkerPoolMaxSurge] = float64(maxSurge)
+			}
+		}
+		for metricName, value := range workerPoolValues {
+			metric, err := prometheus.NewConstMetric(c.descs[metricName], prometheus.GaugeValue, value, shoot.Name, *projectName, worker.Name)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Expose the machine image of the worker pool.
+		if worker.Machine.Image != nil {
+			imageVersion := unknown
+			if worker.Machine.Image.Version != nil {
+				imageVersion = *worker.Machine.Image.Version
+			}
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootWorkerPoolImageInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, worker.Name, worker.Machine.Image.Name, imageVersion)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			} else {
+				ch <- metric
+			}
+		}
+
+		// Expose the size of the root volume of the machines in the worker pool.
+		if worker.Volume != nil {
+			volumeType := unknown
+			if worker.Volume.Type != nil {
+				volumeType = *worker.Volume.Type
+			}
+			volumeSize, err := resource.ParseQuantity(worker.Volume.VolumeSize)
+			if err != nil {
+				c.logger.Errorf("invalid volume size %s in worker pool %s of Shoot %s/%s: %s", worker.Volume.VolumeSize, worker.Name, shoot.Namespace, shoot.Name, err.Error())
+			} else {
+				metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootWorkerPoolVolumeSize], prometheus.GaugeValue, float64(volumeSize.Value()), shoot.Name, *projectName, worker.Name, volumeType)
+				if err != nil {
+					ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				} else {
+					ch <- metric
+				}
+			}
+		}
+
+		// Expose the taints of the worker pool.
+		for _, taint := range worker.Taints {
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootWorkerTaintInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, worker.Name, taint.Key, string(taint.Effect))
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+
+		// Expose the hard eviction thresholds of the kubelets in the worker pool.
+		if worker.Kubernetes == nil || worker.Kubernetes.Kubelet == nil || worker.Kubernetes.Kubelet.EvictionHard == nil {
+			continue
+		}
+		evictionHard := worker.Kubernetes.Kubelet.EvictionHard
+		for signal, threshold := range map[string]*string{
+			"memory.available":   evictionHard.MemoryAvailable,
+			"nodefs.available":   evictionHard.NodeFSAvailable,
+			"nodefs.inodesFree":  evictionHard.NodeFSInodesFree,
+			"imagefs.available":  evictionHard.ImageFSAvailable,
+			"imagefs.inodesFree": evictionHard.ImageFSInodesFree,
+		} {
+			if threshold == nil {
+				continue
+			}
+			value, err := parseEvictionThreshold(*threshold)
+			if err != nil {
+				c.logger.Errorf("invalid eviction threshold %s=%s in worker pool %s of Shoot %s/%s: %s", signal, *threshold, worker.Name, shoot.Namespace, shoot.Name, err.Error())
+				continue
+			}
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootWorkerEvictionHard], prometheus.GaugeValue, value, shoot.Name, *projectName, worker.Name, signal)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+	}
+}
+
+// parseEvictionThreshold converts a kubelet eviction threshold into a float. Percentages
+// are returned as ratio between 0 and 1, quantities as absolute value.
+func parseEvictionThreshold(threshold string) (float64, error) {
+	if strings.HasSuffix(threshold, "%") {
+		percentage, err := strconv.ParseFloat(strings.TrimSuffix(threshold, "%"), 64)
+		if err != nil {
+			return 0, err
+		}
+		return percentage / 100, nil
+	}
+	quantity, err := resource.ParseQuantity(threshold)
+	if err != nil {
+		return 0, err
+	}
+	return float64(quantity.Value()), nil
+}
+
+func (c gardenMetricsCollector) collectShootKubeAPIServerMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	// Expose if legacy authentication methods are enabled. Basic authentication is enabled
+	// by default, hence this is checked also for Shoots without kube apiserver configuration.
+	if wantsBasicAuthentication(shoot) {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootLegacyAuthEnabled], prometheus.GaugeValue, 1, shoot.Name, *projectName, "basic_auth")
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		} else {
+			ch <- metric
+		}
+	}
+
+	kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
+
+	// Expose if an audit policy is configured for the kube apiserver.
+	var auditPolicyConfigured float64
+	if kubeAPIServer != nil && kubeAPIServer.AuditConfig != nil && kubeAPIServer.AuditConfig.AuditPolicy != nil && kubeAPIServer.AuditConfig.AuditPolicy.ConfigMapRef != nil {
+		auditPolicyConfigured = 1
+	}
+	metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootAuditPolicyConfigured], prometheus.GaugeValue, auditPolicyConfigured, shoot.Name, *projectName)
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+	} else {
+		ch <- metric
+	}
+
+	// Expose if OIDC authentication is configured for the kube apiserver, together with the issuer.
+	var (
+		oidcConfigured float64
+		oidcIssuer     string
+	)
+	if kubeAPIServer != nil && kubeAPIServer.OIDCConfig != nil {
+		oidcConfigured = 1
+		if kubeAPIServer.OIDCConfig.IssuerURL != nil {
+			oidcIssuer = *kubeAPIServer.OIDCConfig.IssuerURL
+		}
+	}
+	metric, err = prometheus.NewConstMetric(c.descs[metricGardenShootOIDCConfigured], prometheus.GaugeValue, oidcConfigured, shoot.Name, *projectName, oidcIssuer)
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+	} else {
+		ch <- metric
+	}
+
+	if kubeAPIServer == nil {
+		return
+	}
+
+	// Expose the explicitly configured admission plugins. The default plugins are not exposed.
+	for _, admissionPlugin := range kubeAPIServer.AdmissionPlugins {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootAdmissionPluginInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, admissionPlugin.Name)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+
+	// Expose the service account issuer. Shoots using the default issuer are skipped.
+	if kubeAPIServer.ServiceAccountConfig != nil && kubeAPIServer.ServiceAccountConfig.Issuer != nil {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootServiceAccountIssuerInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, *kubeAPIServer.ServiceAccountConfig.Issuer)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		} else {
+			ch <- metric
+		}
+	}
+}
+
+func (c gardenMetricsCollector) collectShootKubeControllerManagerMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	kubeControllerManager := shoot.Spec.Kubernetes.KubeControllerManager
+	if kubeControllerManager == nil {
+		return
+	}
+
+	// Expose the node CIDR mask size, which limits the count of pods per node. Shoots using the default are skipped.
+	if kubeControllerManager.NodeCIDRMaskSize != nil {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootNodeCIDRMaskSize], prometheus.GaugeValue, float64(*kubeControllerManager.NodeCIDRMaskSize), shoot.Name, *projectName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		} else {
+			ch <- metric
+		}
+	}
+}
+
+// collectShootUnscheduledMetrics exposes that the Shoot is not scheduled to a Seed. The reason is taken from
+// the description of the last operation, Shoots without a described last operation are pending.
+func (c gardenMetricsCollector) collectShootUnscheduledMetrics(shoot *gardenv1beta1.Shoot, projects []*gardenv1beta1.Project, ch chan<- prometheus.Metric) {
+	projectName, err := findProject(projects, shoot.Namespace)
+	if err != nil {
+		c.logger.Error(err.Error())
+		return
+	}
+
+	reason := "pending"
+	if lastOperation := shoot.Status.LastOperation; lastOperation != nil && lastOperation.Description != "" {
+		reason = lastOperation.Description
+	}
+	metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootUnscheduled], prometheus.GaugeValue, 1, shoot.Name, *projectName, reason)
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		return
+	}
+	ch <- metric
+}
+
+// collectShootClusterAutoscalerMetrics exposes the cluster autoscaler settings which are overridden
+// for the Shoot. Settings which are not set in the Shoot specification use the defaults and are skipped.
+func (c gardenMetricsCollector) collectShootClusterAutoscalerMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	clusterAutoscaler := shoot.Spec.Kubernetes.ClusterAutoscaler
+	if clusterAutoscaler == nil {
+		return
+	}
+
+	if clusterAutoscaler.ScaleDownUtilizationThreshold != nil {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootAutoscalerThreshold], prometheus.GaugeValue, *clusterAutoscaler.ScaleDownUtilizationThreshold, shoot.Name, *projectName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		} else {
+			ch <- metric
+		}
+	}
+
+	for setting, duration := range map[string]*metav1.Duration{
+		"scale_down_delay_after_add":     clusterAutoscaler.ScaleDownDelayAfterAdd,
+		"scale_down_delay_after_delete":  clusterAutoscaler.ScaleDownDelayAfterDelete,
+		"scale_down_delay_after_failure": clusterAutoscaler.ScaleDownDelayAfterFailure,
+		"scale_down_unneeded_time":       clusterAutoscaler.ScaleDownUnneededTime,
+		"scan_interval":                  clusterAutoscaler.ScanInterval,
+	} {
+		if duration == nil {
+			continue
+		}
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootAutoscalerDuration], prometheus.GaugeValue, duration.Duration.Seconds(), shoot.Name, *projectName, setting)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
+
+// collectShootLastErrorMetrics exposes the count of last errors of the Shoot per error code.
+// Errors without a well-defined code are counted as unknown.
+func (c gardenMetricsCollector) collectShootLastErrorMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	errorCodes := make(map[string]float64)
+	for _, lastError := range shoot.Status.LastErrors {
+		if len(lastError.Codes) == 0 {
+			errorCodes[unknown]++
+			continue
+		}
+		for _, code := range lastError.Codes {
+			errorCodes[string(code)]++
+		}
+	}
+
+	for code, count := range errorCodes {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootLastError], prometheus.GaugeValue, count, shoot.Name, *projectName, code)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
+
+// collectShootHibernationScheduleMetrics exposes the next start and stop of the hibernation of the Shoot
+// according to its hibernation schedules. If there are multiple schedules, the earliest activation is exposed.
+func (c gardenMetricsCollector) collectShootHibernationScheduleMetrics(shoot *gardenv1beta1.Shoot, projectName *string, now time.Time, ch chan<- prometheus.Metric) {
+	if shoot.Spec.Hibernation == nil {
+		return
+	}
+
+	var nextStart, nextStop time.Time
+	for _, schedule := range shoot.Spec.Hibernation.Schedules {
+		location := time.UTC
+		if schedule.Location != nil {
+			loc, err := time.LoadLocation(*schedule.Location)
+			if err != nil {
+				c.logger.Errorf("invalid location %s in hibernation schedule of Shoot %s/%s: %s", *schedule.Location, shoot.Namespace, shoot.Name, err.Error())
+				continue
+			}
+			location = loc
+		}
+
+		nextStart = c.earliestActivation(shoot, schedule.Start, now.In(location), nextStart)
+		nextStop = c.earliestActivation(shoot, schedule.End, now.In(location), nextStop)
+	}
+
+	for metricName, next := range map[string]time.Time{
+		metricGardenShootHibernationNextStart: nextStart,
+		metricGardenShootHibernationNextStop:  nextStop,
+	} {
+		if next.IsZero() {
+			continue
+		}
+		metric, err := prometheus.NewConstMetric(c.descs[metricName], prometheus.GaugeValue, float64(next.Unix()), shoot.Name, *projectName)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
+
+// earliestActivation returns the next activation of the cron expression after now, if it
+// is earlier than the passed activation. Otherwise the passed activation is returned.
+func (c gardenMetricsCollector) earliestActivation(shoot *gardenv1beta1.Shoot, expression *string, now, activation time.Time) time.Time {
+	if expression == nil {
+		return activation
+	}
+	schedule, err := cron.ParseStandard(*expression)
+	if err != nil {
+		c.logger.Errorf("invalid hibernation schedule of Shoot %s/%s: %s", shoot.Namespace, shoot.Name, err.Error())
+		return activation
+	}
+	// The schedule is evaluated in the location of now. A zero time is returned if there is no activation.
+	if next := schedule.Next(now); !next.IsZero() && (activation.IsZero() || next.Before(activation)) {
+		return next
+	}
+	return activation
+}
+
+// collectShootNetworkingMetrics exposes the networking type and CIDRs of the Shoot. The API does not
+// contain the IP families, hence they are derived from the configured CIDRs.
+func (c gardenMetricsCollector) collectShootNetworkingMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	var (
+		networking = shoot.Spec.Networking
+		cidrs      = make([]string, 3)
+		ipv4, ipv6 bool
+	)
+	for i, cidr := range []*string{networking.Pods, networking.Services, networking.Nodes} {
+		cidrs[i] = unknown
+		if cidr == nil {
+			continue
+		}
+		cidrs[i] = *cidr
+		if ip, _, err := net.ParseCIDR(*cidr); err == nil {
+			if ip.To4() != nil {
+				ipv4 = true
+			} else {
+				ipv6 = true
+			}
+		}
+	}
+
+	var ipFamilies []string
+	if ipv4 {
+		ipFamilies = append(ipFamilies, "IPv4")
+	}
+	if ipv6 {
+		ipFamilies = append(ipFamilies, "IPv6")
+	}
+
+	metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootNetworkingInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, networking.Type, cidrs[0], cidrs[1], cidrs[2], strings.Join(ipFamilies, ","))
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		return
+	}
+	ch <- metric
+}
+
+// collectShootExtensionMetrics exposes the extensions which are enabled for the Shoot,
+// either via the Shoot specification or because they are enabled globally.
+func (c gardenMetricsCollector) collectShootExtensionMetrics(shoot *gardenv1beta1.Shoot, projectName *string, globalExtensions []string, ch chan<- prometheus.Metric) {
+	extensionTypes := make(map[string]struct{}, len(shoot.Spec.Extensions)+len(globalExtensions))
+	for _, extension := range shoot.Spec.Extensions {
+		extensionTypes[extension.Type] = struct{}{}
+	}
+	for _, extensionType := range globalExtensions {
+		extensionTypes[extensionType] = struct{}{}
+	}
+
+	for extensionType := range extensionTypes {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootExtensionEnabled], prometheus.GaugeValue, 1, shoot.Name, *projectName, extensionType)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
+
+// globallyEnabledExtensions returns the types of the Extension resources which are enabled for all Shoots.
+func globallyEnabledExtensions(controllerRegistrations []*gardenv1beta1.ControllerRegistration) []string {
+	var extensionTypes []string
+	for _, controllerRegistration := range controllerRegistrations {
+		for _, resource := range controllerRegistration.Spec.Resources {
+			if resource.Kind == "Extension" && resource.GloballyEnabled != nil && *resource.GloballyEnabled {
+				extensionTypes = append(extensionTypes, resource.Type)
+			}
+		}
+	}
+	return extensionTypes
+}
+
+// collectShootAddonMetrics exposes if the addons are enabled for the Shoot.
+// Addons which are not configured are exposed as disabled.
+func (c gardenMetricsCollector) collectShootAddonMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	var dashboardEnabled, nginxIngressEnabled bool
+	if addons := shoot.Spec.Addons; addons != nil {
+		dashboardEnabled = addons.KubernetesDashboard != nil && addons.KubernetesDashboard.Enabled
+		nginxIngressEnabled = addons.NginxIngress != nil && addons.NginxIngress.Enabled
+	}
+
+	for addon, enabled := range map[string]bool{
+		"kubernetes-dashboard": dashboardEnabled,
+		"nginx-ingress":        nginxIngressEnabled,
+	} {
+		var value float64
+		if enabled {
+			value = 1
+		}
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootAddon], prometheus.GaugeValue, value, shoot.Name, *projectName, addon)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
+
+// collectShootEnvironmentMetrics exposes the environment of the Shoot, which is read from the configured
+// label of the project namespace. Shoots whose namespace does not carry the label are skipped.
+func (c gardenMetricsCollector) collectShootEnvironmentMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	if c.namespaceInformer == nil {
+		return
+	}
+	namespace, err := c.namespaceInformer.Lister().Get(shoot.Namespace)
+	if err != nil {
+		return
+	}
+	environment, ok := namespace.Labels[c.environmentLabel]
+	if !ok {
+		return
+	}
+	metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootEnvironmentInfo], prometheus.GaugeValue, 0, shoot.Name, *projectName, environment)
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		return
+	}
+	ch <- metric
+}
+
+// collectShootFeatureGateMetrics exposes the feature gates of the Kubernetes components of the Shoot.
+// Components without configuration or without feature gates are skipped.
+func (c gardenMetricsCollector) collectShootFeatureGateMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	kubernetes := shoot.Spec.Kubernetes
+	featureGatesByComponent := make(map[string]map[string]bool)
+	if kubernetes.KubeAPIServer != nil {
+		featureGatesByComponent["kube-apiserver"] = kubernetes.KubeAPIServer.FeatureGates
+	}
+	if kubernetes.KubeControllerManager != nil {
+		featureGatesByComponent["kube-controller-manager"] = kubernetes.KubeControllerManager.FeatureGates
+	}
+	if kubernetes.KubeScheduler != nil {
+		featureGatesByComponent["kube-scheduler"] = kubernetes.KubeScheduler.FeatureGates
+	}
+	if kubernetes.KubeProxy != nil {
+		featureGatesByComponent["kube-proxy"] = kubernetes.KubeProxy.FeatureGates
+	}
+	if kubernetes.Kubelet != nil {
+		featureGatesByComponent["kubelet"] = kubernetes.Kubelet.FeatureGates
+	}
+
+	for component, featureGates := range featureGatesByComponent {
+		for featureGate, enabled := range featureGates {
+			var value float64
+			if enabled {
+				value = 1
+			}
+			metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootFeatureGateEnabled], prometheus.GaugeValue, value, shoot.Name, *projectName, featureGate, component)
+			if err != nil {
+				ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+	}
+}
+
+// collectShootBackupMetrics exposes the health of the BackupEntry which belongs to the Shoot.
+// Shoots without a BackupEntry, e.g. when their Seed has no backup configured, are skipped.
+func (c gardenMetricsCollector) collectShootBackupMetrics(shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
+	if shoot.Status.TechnicalID == "" || shoot.Status.UID == "" {
+		return
+	}
+	backupEntry, err := c.backupEntryInformer.Lister().BackupEntries(shoot.Namespace).Get(backupEntryName(shoot))
+	if err != nil {
+		return
+	}
+
+	var backupOK float64
+	if backupEntry.Status.LastError == nil && backupEntry.Status.LastOperation != nil && backupEntry.Status.LastOperation.State == gardenv1beta1.LastOperationStateSucceeded {
+		backupOK = 1
+	}
+	metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootBackupOK], prometheus.GaugeValue, backupOK, shoot.Name, *projectName)
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		return
+	}
+	ch <- metric
+}
+
+// changedRecently checks if the Shoot has changed within the window configured to restrict
+// the detailed Shoot metrics. The last operation is used to determine the time of the last
+// change and the creation timestamp in case the Shoot has no last operation yet.
+func (c gardenMetricsCollector) changedRecently(shoot *gardenv1beta1.Shoot, now time.Time) bool {
+	if c.onlyRecentlyChanged == 0 {
+		return true
+	}
+	lastChange := shoot.CreationTimestamp.Time
+	if shoot.Status.LastOperation != nil {
+		lastChange = shoot.Status.LastOperation.LastUpdateTime.Time
+	}
+	return now.Sub(lastChange) <= c.onlyRecentlyChanged
+}
+
+// collectShootForcedUpgradeMetrics exposes if the Kubernetes version of the Shoot expires within the forced
+// upgrade window and the Shoot has the automatic Kubernetes version update enabled. Versions which are
+// already expired are reported as imminent as well, as they are updated during the next maintenance.
+func (c gardenMetricsCollector) collectShootForcedUpgradeMetrics(shoot *gardenv1beta1.Shoot, projectName *string, cloudProfile *gardenv1beta1.CloudProfile, now time.Time, ch chan<- prometheus.Metric) {
+	if cloudProfile == nil {
+		return
+	}
+
+	var imminent float64
+	if shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.AutoUpdate != nil && shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion {
+		for _, version := range cloudProfile.Spec.Kubernetes.Versions {
+			if version.Version != shoot.Spec.Kubernetes.Version {
+				continue
+			}
+			if version.ExpirationDate != nil && version.ExpirationDate.Time.Before(now.Add(c.forcedUpgradeWindow)) {
+				imminent = 1
+			}
+			break
+		}
+	}
+
+	metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootForcedUpgradeImminent], prometheus.GaugeValue, imminent, shoot.Name, *projectName, shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		return
+	}
+	ch <- metric
+}
+
+// collectShootAgeMetrics exposes the distribution of the Shoot ages as histogram.
+// Shoots which are in deletion are not considered.
+func (c gardenMetricsCollector) collectShootAgeMetrics(shoots []*gardenv1beta1.Shoot, now time.Time, ch chan<- prometheus.Metric) {
+	var (
+		count   uint64
+		sum     float64
+		buckets = make(map[float64]uint64, len(shootAgeBuckets))
+	)
+	for _, bucket := range shootAgeBuckets {
+		buckets[bucket] = 0
+	}
+
+	for _, shoot := range shoots {
+		if shoot == nil || shoot.DeletionTimestamp != nil {
+			continue
+		}
+		age := now.Sub(shoot.CreationTimestamp.Time).Seconds()
+		count++
+		sum += age
+		for _, bucket := range shootAgeBuckets {
+			if age <= bucket {
+				buckets[bucket]++
+			}
+		}
+	}
+
+	metric, err := prometheus.NewConstHistogram(c.descs[metricGardenShootsAge], count, sum, buckets)
+	if err != nil {
+		ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		return
+	}
+	ch <- metric
+}
+
+// filterShard returns the Shoots which belong to the shard of the exporter.
+func (c gardenMetricsCollector) filterShard(shoots []*gardenv1beta1.Shoot) []*gardenv1beta1.Shoot {
+	if c.shardTotal <= 1 {
+		return shoots
+	}
+	filtered := make([]*gardenv1beta1.Shoot, 0, len(shoots)/int(c.shardTotal)+1)
+	for _, shoot := range shoots {
+		if c.inShard(shoot) {
+			filtered = append(filtered, shoot)
+		}
+	}
+	return filtered
+}
+
+// inShard checks if the Shoot belongs to the shard of the exporter.
+// The Shoots are assigned to a shard based on the hash of their uid.
+func (c gardenMetricsCollector) inShard(shoot *gardenv1beta1.Shoot) bool {
+	if c.shardTotal <= 1 {
+		return true
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(shoot.UID))
+	return hash.Sum32()%c.shardTotal == c.shardIndex
+}
+
 // exposeShootOperations is a util function which is used to transform a map
 // of Shoot operations information into proper metrics and to pass them to the collector.
 func (c gardenMetricsCollector) exposeShootOperations(shootOperations map[string]float64, ch chan<- prometheus.Metric) {
@@ -229,6 +1225,33 @@ func (c gardenMetricsCollector) exposeShootOperations(shootOperations map[string
 	}
 }
 
+// exposeProjectShootPurposes is a util function which is used to transform a map
+// of Shoot purpose counts per project into proper metrics and to pass them to the collector.
+func (c gardenMetricsCollector) exposeProjectShootPurposes(projectPurposes map[string]float64, ch chan<- prometheus.Metric) {
+	for purposeInfos, count := range projectPurposes {
+		labels := strings.Split(purposeInfos, ":")
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenProjectShootsPurpose], prometheus.GaugeValue, count, labels...)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots-purposes"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
+
+// exposeShootPurposes is a util function which is used to transform a map
+// of Shoot purpose counts into proper metrics and to pass them to the collector.
+func (c gardenMetricsCollector) exposeShootPurposes(purposes map[string]float64, ch chan<- prometheus.Metric) {
+	for purpose, count := range purposes {
+		metric, err := prometheus.NewConstMetric(c.descs[metricGardenShootsByPurpose], prometheus.GaugeValue, count, purpose)
+		if err != nil {
+			ScrapeFailures.With(prometheus.Labels{"kind": "shoots-purposes"}).Inc()
+			continue
+		}
+		ch <- metric
+	}
+}
+
 func (c gardenMetricsCollector) exposeAPIServerResponseTime(condition gardenv1beta1.Condition, shoot *gardenv1beta1.Shoot, projectName *string, ch chan<- prometheus.Metric) {
 	match := shootHealthProbeResponseTimeRegExp.FindAllStringSubmatch(condition.Message, -1)
 	if len(match) != 1 || len(match[0]) != 2 {
@@ -0,0 +1,627 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeinformers "k8s.io/client-go/informers"
+)
+
+func TestCollectShootAlertReceiverMetrics(t *testing.T) {
+	var (
+		withReceivers    = newTestShoot("garden-dev", "with-receivers", "uid-1")
+		withoutReceivers = newTestShoot("garden-dev", "without-receivers", "uid-2")
+	)
+	withReceivers.Spec.Monitoring = &gardenv1beta1.Monitoring{
+		Alerting: &gardenv1beta1.Alerting{EmailReceivers: []string{"ops@example.com", "oncall@example.com"}},
+	}
+
+	c := newTestCollector(t, newTestProject("dev", "garden-dev"), withReceivers, withoutReceivers)
+	collected := collectSeries(c.collectShootMetrics)
+
+	// Shoots without alerting receivers are skipped.
+	assertValues(t, collected[c.descs[metricGardenShootAlertReceiverInfo]], map[string]float64{
+		"name=with-receivers,project=dev,receiver=ops@example.com":    0,
+		"name=with-receivers,project=dev,receiver=oncall@example.com": 0,
+	})
+}
+
+func TestCollectShootServiceAccountIssuerMetrics(t *testing.T) {
+	var (
+		projectName   = "dev"
+		issuer        = "https://issuer.example.com"
+		customIssuer  = newTestShoot("garden-dev", "custom-issuer", "uid-1")
+		defaultIssuer = newTestShoot("garden-dev", "default-issuer", "uid-2")
+		c             = newTestCollector(t)
+	)
+	customIssuer.Spec.Kubernetes.KubeAPIServer = &gardenv1beta1.KubeAPIServerConfig{
+		ServiceAccountConfig: &gardenv1beta1.ServiceAccountConfig{Issuer: &issuer},
+	}
+	defaultIssuer.Spec.Kubernetes.KubeAPIServer = &gardenv1beta1.KubeAPIServerConfig{
+		ServiceAccountConfig: &gardenv1beta1.ServiceAccountConfig{},
+	}
+
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		c.collectShootKubeAPIServerMetrics(customIssuer, &projectName, ch)
+		c.collectShootKubeAPIServerMetrics(defaultIssuer, &projectName, ch)
+	})
+	// Shoots using the default issuer are skipped.
+	assertValues(t, collected[c.descs[metricGardenShootServiceAccountIssuerInfo]], map[string]float64{
+		"issuer=https://issuer.example.com,name=custom-issuer,project=dev": 0,
+	})
+}
+
+func TestCollectShootBackupMetrics(t *testing.T) {
+	newShoot := func(name, uid string) *gardenv1beta1.Shoot {
+		shoot := newTestShoot("garden-dev", name, uid)
+		shoot.Status.TechnicalID = "shoot--dev--" + name
+		shoot.Status.UID = types.UID(uid)
+		return shoot
+	}
+	newBackupEntry := func(shoot *gardenv1beta1.Shoot, state gardenv1beta1.LastOperationState) *gardenv1beta1.BackupEntry {
+		backupEntry := &gardenv1beta1.BackupEntry{}
+		backupEntry.Namespace, backupEntry.Name = shoot.Namespace, backupEntryName(shoot)
+		backupEntry.Status.LastOperation = &gardenv1beta1.LastOperation{Type: gardenv1beta1.LastOperationTypeReconcile, State: state}
+		return backupEntry
+	}
+
+	var (
+		projectName = "dev"
+		healthy     = newShoot("healthy", "uid-1")
+		failed      = newShoot("failed", "uid-2")
+		noBackup    = newShoot("no-backup", "uid-3")
+		c           = newTestCollector(t, newBackupEntry(healthy, gardenv1beta1.LastOperationStateSucceeded), newBackupEntry(failed, gardenv1beta1.LastOperationStateFailed))
+	)
+
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		for _, shoot := range []*gardenv1beta1.Shoot{healthy, failed, noBackup} {
+			c.collectShootBackupMetrics(shoot, &projectName, ch)
+		}
+	})
+	// Shoots without a BackupEntry are skipped.
+	assertValues(t, collected[c.descs[metricGardenShootBackupOK]], map[string]float64{
+		"name=healthy,project=dev": 1,
+		"name=failed,project=dev":  0,
+	})
+}
+
+func TestCollectShootLegacyAuthMetrics(t *testing.T) {
+	var (
+		projectName       = "dev"
+		enabled, disabled = true, false
+		defaulted         = newTestShoot("garden-dev", "defaulted", "uid-1")
+		basicAuth         = newTestShoot("garden-dev", "basic-auth", "uid-2")
+		noBasicAuth       = newTestShoot("garden-dev", "no-basic-auth", "uid-3")
+		c                 = newTestCollector(t)
+	)
+	basicAuth.Spec.Kubernetes.KubeAPIServer = &gardenv1beta1.KubeAPIServerConfig{EnableBasicAuthentication: &enabled}
+	noBasicAuth.Spec.Kubernetes.KubeAPIServer = &gardenv1beta1.KubeAPIServerConfig{EnableBasicAuthentication: &disabled}
+
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		for _, shoot := range []*gardenv1beta1.Shoot{defaulted, basicAuth, noBasicAuth} {
+			c.collectShootKubeAPIServerMetrics(shoot, &projectName, ch)
+		}
+	})
+	// Basic authentication is enabled by default, disabled methods are skipped.
+	assertValues(t, collected[c.descs[metricGardenShootLegacyAuthEnabled]], map[string]float64{
+		"name=defaulted,project=dev,type=basic_auth":  1,
+		"name=basic-auth,project=dev,type=basic_auth": 1,
+	})
+}
+
+func TestCollectShootEnvironmentMetrics(t *testing.T) {
+	var (
+		labeled    = newTestShoot("garden-dev", "labeled", "uid-1")
+		unlabeled  = newTestShoot("garden-test", "unlabeled", "uid-2")
+		namespaces = kubeinformers.NewSharedInformerFactory(nil, 0).Core().V1().Namespaces()
+	)
+	for _, namespace := range []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "garden-dev", Labels: map[string]string{"environment": "prod"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "garden-test"}},
+	} {
+		if err := namespaces.Informer().GetIndexer().Add(namespace); err != nil {
+			t.Fatalf("cannot add namespace: %s", err.Error())
+		}
+	}
+
+	c := newTestCollector(t, newTestProject("dev", "garden-dev"), newTestProject("test", "garden-test"), labeled, unlabeled)
+	c.namespaceInformer = namespaces
+	c.environmentLabel = "environment"
+	collected := collectSeries(c.collectShootMetrics)
+
+	// Shoots whose project namespace does not carry the label are skipped.
+	assertValues(t, collected[c.descs[metricGardenShootEnvironmentInfo]], map[string]float64{
+		"environment=prod,name=labeled,project=dev": 0,
+	})
+}
+
+func TestCollectShootClusterIdentityMetrics(t *testing.T) {
+	var (
+		withIdentity    = newTestShoot("garden-dev", "with-identity", "uid-1")
+		withoutIdentity = newTestShoot("garden-dev", "without-identity", "uid-2")
+	)
+	withIdentity.Status.TechnicalID = "shoot--dev--with-identity"
+
+	c := newTestCollector(t, newTestProject("dev", "garden-dev"), withIdentity, withoutIdentity)
+	collected := collectSeries(c.collectShootMetrics)
+
+	// Shoots without a technical id yet are skipped.
+	assertValues(t, collected[c.descs[metricGardenShootClusterIdentityInfo]], map[string]float64{
+		"cluster_identity=shoot--dev--with-identity,name=with-identity,project=dev": 0,
+	})
+}
+
+func TestCollectShootAgeMetrics(t *testing.T) {
+	now := time.Date(2020, time.June, 10, 10, 0, 0, 0, time.UTC)
+	newShoot := func(age time.Duration) *gardenv1beta1.Shoot {
+		shoot := newTestShoot("garden-dev", "foo", "uid-1")
+		shoot.CreationTimestamp = metav1.NewTime(now.Add(-age))
+		return shoot
+	}
+	deleted := newShoot(time.Hour)
+	deletionTimestamp := metav1.NewTime(now)
+	deleted.DeletionTimestamp = &deletionTimestamp
+
+	shoots := []*gardenv1beta1.Shoot{
+		newShoot(30 * time.Minute),
+		// The upper bounds of the buckets are inclusive.
+		newShoot(time.Hour),
+		newShoot(48 * time.Hour),
+		// Shoots in deletion are not considered.
+		deleted,
+		nil,
+	}
+
+	c := newTestCollector(t)
+	ch := make(chan prometheus.Metric, 1)
+	c.collectShootAgeMetrics(shoots, now, ch)
+	m := &dto.Metric{}
+	if err := (<-ch).Write(m); err != nil {
+		t.Fatalf("cannot write metric: %s", err.Error())
+	}
+
+	if got := m.Histogram.GetSampleCount(); got != 3 {
+		t.Errorf("got sample count %d, want 3", got)
+	}
+	if got, want := m.Histogram.GetSampleSum(), (30*time.Minute + time.Hour + 48*time.Hour).Seconds(); got != want {
+		t.Errorf("got sample sum %v, want %v", got, want)
+	}
+	// The buckets are cumulative.
+	want := map[float64]uint64{
+		time.Hour.Seconds():                     2,
+		(6 * time.Hour).Seconds():               2,
+		(24 * time.Hour).Seconds():              2,
+		(7 * 24 * time.Hour).Seconds():          3,
+		shootAgeBuckets[len(shootAgeBuckets)-1]: 3,
+	}
+	if len(m.Histogram.Bucket) != len(shootAgeBuckets) {
+		t.Errorf("got %d buckets, want %d", len(m.Histogram.Bucket), len(shootAgeBuckets))
+	}
+	for _, bucket := range m.Histogram.Bucket {
+		if count, ok := want[bucket.GetUpperBound()]; ok && bucket.GetCumulativeCount() != count {
+			t.Errorf("bucket %v: got %d, want %d", bucket.GetUpperBound(), bucket.GetCumulativeCount(), count)
+		}
+	}
+}
+
+func TestCollectShootFeatureGateMetrics(t *testing.T) {
+	var (
+		projectName = "dev"
+		shoot       = newTestShoot("garden-dev", "foo", "uid-1")
+		c           = newTestCollector(t)
+	)
+	shoot.Spec.Kubernetes.KubeAPIServer = &gardenv1beta1.KubeAPIServerConfig{
+		KubernetesConfig: gardenv1beta1.KubernetesConfig{FeatureGates: map[string]bool{"TTLAfterFinished": true}},
+	}
+	shoot.Spec.Kubernetes.Kubelet = &gardenv1beta1.KubeletConfig{
+		KubernetesConfig: gardenv1beta1.KubernetesConfig{FeatureGates: map[string]bool{"TTLAfterFinished": true, "CSIMigration": false}},
+	}
+	// Components without configuration are skipped.
+	shoot.Spec.Kubernetes.KubeScheduler = &gardenv1beta1.KubeSchedulerConfig{}
+
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		c.collectShootFeatureGateMetrics(shoot, &projectName, ch)
+	})
+	assertValues(t, collected[c.descs[metricGardenShootFeatureGateEnabled]], map[string]float64{
+		"component=kube-apiserver,feature_gate=TTLAfterFinished,name=foo,project=dev": 1,
+		"component=kubelet,feature_gate=TTLAfterFinished,name=foo,project=dev":        1,
+		"component=kubelet,feature_gate=CSIMigration,name=foo,project=dev":            0,
+	})
+}
+
+func TestCollectShootNodeCIDRMaskSizeMetrics(t *testing.T) {
+	var (
+		projectName = "dev"
+		maskSize    = int32(25)
+		configured  = newTestShoot("garden-dev", "configured", "uid-1")
+		defaulted   = newTestShoot("garden-dev", "defaulted", "uid-2")
+		c           = newTestCollector(t)
+	)
+	configured.Spec.Kubernetes.KubeControllerManager = &gardenv1beta1.KubeControllerManagerConfig{NodeCIDRMaskSize: &maskSize}
+	defaulted.Spec.Kubernetes.KubeControllerManager = &gardenv1beta1.KubeControllerManagerConfig{}
+
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		c.collectShootKubeControllerManagerMetrics(configured, &projectName, ch)
+		c.collectShootKubeControllerManagerMetrics(defaulted, &projectName, ch)
+	})
+	// Shoots using the default mask size are skipped.
+	assertValues(t, collected[c.descs[metricGardenShootNodeCIDRMaskSize]], map[string]float64{
+		"name=configured,project=dev": 25,
+	})
+}
+
+func TestEarliestActivation(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("cannot load location: %s", err.Error())
+	}
+	var (
+		c     = gardenMetricsCollector{logger: newTestLogger()}
+		shoot = &gardenv1beta1.Shoot{}
+		// Wednesday, 2020-06-10 10:30 UTC, which is 12:30 in Berlin.
+		now = time.Date(2020, time.June, 10, 10, 30, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name       string
+		expression *string
+		location   *time.Location
+		activation time.Time
+		want       time.Time
+	}{
+		{
+			name: "no expression",
+		},
+		{
+			name:       "invalid expression",
+			expression: stringPtr("0 25 * * *"),
+		},
+		{
+			name:       "later on the same day",
+			expression: stringPtr("0 19 * * *"),
+			location:   time.UTC,
+			want:       time.Date(2020, time.June, 10, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "evaluated in the schedule location",
+			expression: stringPtr("0 12 * * *"),
+			location:   berlin,
+			want:       time.Date(2020, time.June, 11, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "day of week names",
+			expression: stringPtr("0 8 * * MON-FRI"),
+			location:   berlin,
+			want:       time.Date(2020, time.June, 11, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "daily descriptor",
+			expression: stringPtr("@daily"),
+			location:   berlin,
+			want:       time.Date(2020, time.June, 10, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "hourly descriptor",
+			expression: stringPtr("@hourly"),
+			location:   time.UTC,
+			want:       time.Date(2020, time.June, 10, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "interval descriptor",
+			expression: stringPtr("@every 1h"),
+			location:   time.UTC,
+			want:       time.Date(2020, time.June, 10, 11, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "earlier activation is kept",
+			expression: stringPtr("0 19 * * *"),
+			location:   time.UTC,
+			activation: time.Date(2020, time.June, 10, 11, 0, 0, 0, time.UTC),
+			want:       time.Date(2020, time.June, 10, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "later activation is replaced",
+			expression: stringPtr("0 19 * * *"),
+			location:   time.UTC,
+			activation: time.Date(2020, time.June, 11, 11, 0, 0, 0, time.UTC),
+			want:       time.Date(2020, time.June, 10, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "no activation",
+			expression: stringPtr("0 0 31 2 *"),
+			location:   time.UTC,
+		},
+	}
+	for _, tt := range tests {
+		location := tt.location
+		if location == nil {
+			location = time.UTC
+		}
+		if got := c.earliestActivation(shoot, tt.expression, now.In(location), tt.activation); !got.Equal(tt.want) {
+			t.Errorf("%s: got %s, want %s", tt.name, got.UTC(), tt.want)
+		}
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestInShard(t *testing.T) {
+	const shardTotal = 3
+	for i := 0; i < 100; i++ {
+		shoot := &gardenv1beta1.Shoot{}
+		shoot.UID = types.UID(fmt.Sprintf("6f3b8c2e-%04d-4a1e-9c55-0242ac120002", i))
+
+		var shards int
+		for index := uint32(0); index < shardTotal; index++ {
+			c := gardenMetricsCollector{shardIndex: index, shardTotal: shardTotal}
+			if c.inShard(shoot) {
+				shards++
+			}
+			if got := len(c.filterShard([]*gardenv1beta1.Shoot{shoot})); got > 0 != c.inShard(shoot) {
+				t.Errorf("uid %s: filterShard of shard %d returned %d Shoots", shoot.UID, index, got)
+			}
+		}
+		if shards != 1 {
+			t.Errorf("uid %s: got %d shards, want exactly 1", shoot.UID, shards)
+		}
+	}
+
+	// Without sharding, all Shoots belong to the exporter.
+	for _, shardTotal := range []uint32{0, 1} {
+		c := gardenMetricsCollector{shardTotal: shardTotal}
+		if !c.inShard(&gardenv1beta1.Shoot{}) {
+			t.Errorf("shard total %d: Shoot is not in shard", shardTotal)
+		}
+	}
+}
+
+func TestCollectShootUnscheduledMetrics(t *testing.T) {
+	var (
+		scheduled   = newTestShoot("garden-dev", "scheduled", "uid-1")
+		unscheduled = newTestShoot("garden-dev", "unscheduled", "uid-2")
+		failed      = newTestShoot("garden-dev", "failed", "uid-3")
+	)
+	unscheduled.Spec.SeedName = nil
+	unscheduled.Status.LastOperation = nil
+	failed.Spec.SeedName = nil
+	failed.Status.LastOperation.State = gardenv1beta1.LastOperationStateError
+	failed.Status.LastOperation.Description = "no matching seed candidate found"
+
+	c := newTestCollector(t, newTestProject("dev", "garden-dev"), scheduled, unscheduled, failed)
+	collected := collectSeries(c.collectShootMetrics)
+
+	assertValues(t, collected[c.descs[metricGardenShootUnscheduled]], map[string]float64{
+		"name=unscheduled,project=dev,reason=pending":                     1,
+		"name=failed,project=dev,reason=no matching seed candidate found": 1,
+	})
+	// Unscheduled Shoots are not exposed with the detailed metrics.
+	if _, ok := collected[c.descs[metricGardenShootHibernated]]["name=unscheduled,project=dev,uid=uid-2"]; ok {
+		t.Errorf("unscheduled Shoot is exposed as hibernated")
+	}
+}
+
+func TestCollectShootWorkerMetricsLabels(t *testing.T) {
+	var (
+		memoryAvailable = "10%"
+		projectName     = "dev"
+		shoot           = newTestShoot("garden-dev", "foo", "uid-1")
+		c               = newTestCollector(t)
+	)
+	shoot.Spec.Provider.Workers = []gardenv1beta1.Worker{{
+		Name:    "pool-a",
+		Minimum: 1,
+		Maximum: 3,
+		Taints:  []corev1.Taint{{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule}},
+		Kubernetes: &gardenv1beta1.WorkerKubernetes{
+			Kubelet: &gardenv1beta1.KubeletConfig{
+				EvictionHard: &gardenv1beta1.KubeletConfigEviction{MemoryAvailable: &memoryAvailable},
+			},
+		},
+	}}
+
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		c.collectShootWorkerMetrics(shoot, &projectName, ch)
+	})
+	// All worker pool metrics identify the worker pool with the same label.
+	for desc, series := range collected {
+		for pairs := range series {
+			if !strings.Contains(pairs, "worker_pool=pool-a") {
+				t.Errorf("series %s{%s} has no worker_pool label", desc, pairs)
+			}
+		}
+	}
+	assertValues(t, collected[c.descs[metricGardenShootWorkerEvictionHard]], map[string]float64{
+		"name=foo,project=dev,signal=memory.available,worker_pool=pool-a": 0.1,
+	})
+	assertValues(t, collected[c.descs[metricGardenShootWorkerTaintInfo]], map[string]float64{
+		"effect=NoSchedule,key=dedicated,name=foo,project=dev,worker_pool=pool-a": 0,
+	})
+}
+
+func TestCollectProjectShootPurposeMetrics(t *testing.T) {
+	var (
+		evaluation = newTestShoot("garden-dev", "evaluation", "uid-1")
+		noPurpose  = newTestShoot("garden-dev", "no-purpose", "uid-2")
+		unchanged  = newTestShoot("garden-dev", "unchanged", "uid-3")
+		production = newTestShoot("garden-prod", "production", "uid-4")
+		purpose    = gardenv1beta1.ShootPurposeProduction
+	)
+	noPurpose.Spec.Purpose = nil
+	unchanged.Status.LastOperation.LastUpdateTime = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	production.Spec.Purpose = &purpose
+
+	c := newTestCollector(t, newTestProject("dev", "garden-dev"), newTestProject("prod", "garden-prod"), evaluation, noPurpose, unchanged, production)
+	// Shoots which have not changed recently are still counted.
+	c.onlyRecentlyChanged = time.Hour
+
+	collected := collectSeries(c.collectShootMetrics)
+	assertValues(t, collected[c.descs[metricGardenProjectShootsPurpose]], map[string]float64{
+		"project=dev,purpose=evaluation":  2,
+		"project=dev,purpose=unknown":     1,
+		"project=prod,purpose=production": 1,
+	})
+}
+
+func TestCollectShootWorkerTaintMetrics(t *testing.T) {
+	var (
+		projectName = "dev"
+		shoot       = newTestShoot("garden-dev", "foo", "uid-1")
+		c           = newTestCollector(t)
+	)
+	shoot.Spec.Provider.Workers = []gardenv1beta1.Worker{
+		{Name: "untainted"},
+		{Name: "gpu", Taints: []corev1.Taint{
+			{Key: "nvidia.com/gpu", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "nvidia.com/gpu", Effect: corev1.TaintEffectNoExecute},
+		}},
+	}
+
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		c.collectShootWorkerMetrics(shoot, &projectName, ch)
+	})
+	assertValues(t, collected[c.descs[metricGardenShootWorkerTaintInfo]], map[string]float64{
+		"effect=NoSchedule,key=nvidia.com/gpu,name=foo,project=dev,worker_pool=gpu": 0,
+		"effect=NoExecute,key=nvidia.com/gpu,name=foo,project=dev,worker_pool=gpu":  0,
+	})
+}
+
+func TestChangedRecently(t *testing.T) {
+	var (
+		now          = time.Now()
+		withoutOp    = newTestShoot("garden-dev", "foo", "uid-1")
+		oldOperation = newTestShoot("garden-dev", "bar", "uid-2")
+		newOperation = newTestShoot("garden-dev", "baz", "uid-3")
+	)
+	withoutOp.Status.LastOperation = nil
+	withoutOp.CreationTimestamp = metav1.NewTime(now.Add(-30 * time.Minute))
+	oldOperation.Status.LastOperation.LastUpdateTime = metav1.NewTime(now.Add(-2 * time.Hour))
+	newOperation.CreationTimestamp = metav1.NewTime(now.Add(-48 * time.Hour))
+	newOperation.Status.LastOperation.LastUpdateTime = metav1.NewTime(now.Add(-time.Minute))
+
+	tests := []struct {
+		name   string
+		window time.Duration
+		shoot  *gardenv1beta1.Shoot
+		want   bool
+	}{
+		{"disabled", 0, oldOperation, true},
+		{"created within the window", time.Hour, withoutOp, true},
+		{"created before the window", 10 * time.Minute, withoutOp, false},
+		{"operation updated before the window", time.Hour, oldOperation, false},
+		{"operation updated within the window", time.Hour, newOperation, true},
+	}
+	for _, tt := range tests {
+		c := gardenMetricsCollector{onlyRecentlyChanged: tt.window}
+		if got := c.changedRecently(tt.shoot, now); got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCollectShootMetricsOnlyRecentlyChanged(t *testing.T) {
+	var (
+		changed   = newTestShoot("garden-dev", "changed", "uid-1")
+		unchanged = newTestShoot("garden-dev", "unchanged", "uid-2")
+	)
+	unchanged.Status.LastOperation.LastUpdateTime = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+
+	c := newTestCollector(t, newTestProject("dev", "garden-dev"), changed, unchanged)
+	c.onlyRecentlyChanged = time.Hour
+
+	collected := collectSeries(c.collectShootMetrics)
+	assertValues(t, collected[c.descs[metricGardenShootHibernated]], map[string]float64{
+		"name=changed,project=dev,uid=uid-1": 0,
+	})
+	// The aggregated metrics still consider all Shoots.
+	var operations float64
+	for _, count := range collected[c.descs[metricGardenOperationsTotal]] {
+		operations += count
+	}
+	if operations != 2 {
+		t.Errorf("got %v Shoots with operations, want 2", operations)
+	}
+}
+
+func TestCollectShootForcedUpgradeMetrics(t *testing.T) {
+	var (
+		projectName  = "dev"
+		now          = time.Now()
+		cloudProfile = &gardenv1beta1.CloudProfile{}
+		expiring     = newTestShoot("garden-dev", "expiring", "uid-1")
+		supported    = newTestShoot("garden-dev", "supported", "uid-2")
+		expired      = newTestShoot("garden-dev", "expired", "uid-3")
+		noAutoUpdate = newTestShoot("garden-dev", "no-auto-update", "uid-4")
+		c            = newTestCollector(t)
+	)
+	c.forcedUpgradeWindow = 7 * 24 * time.Hour
+	cloudProfile.Spec.Kubernetes.Versions = []gardenv1beta1.ExpirableVersion{
+		{Version: "1.15.11", ExpirationDate: &metav1.Time{Time: now.Add(-24 * time.Hour)}},
+		{Version: "1.16.8", ExpirationDate: &metav1.Time{Time: now.Add(3 * 24 * time.Hour)}},
+		{Version: "1.17.4", ExpirationDate: &metav1.Time{Time: now.Add(30 * 24 * time.Hour)}},
+	}
+	for _, shoot := range []*gardenv1beta1.Shoot{expiring, supported, expired} {
+		shoot.Spec.Maintenance = &gardenv1beta1.Maintenance{AutoUpdate: &gardenv1beta1.MaintenanceAutoUpdate{KubernetesVersion: true}}
+	}
+	noAutoUpdate.Spec.Maintenance = &gardenv1beta1.Maintenance{AutoUpdate: &gardenv1beta1.MaintenanceAutoUpdate{KubernetesVersion: false}}
+	supported.Spec.Kubernetes.Version = "1.17.4"
+	expired.Spec.Kubernetes.Version = "1.15.11"
+
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		for _, shoot := range []*gardenv1beta1.Shoot{expiring, supported, expired, noAutoUpdate} {
+			c.collectShootForcedUpgradeMetrics(shoot, &projectName, cloudProfile, now, ch)
+		}
+	})
+	assertValues(t, collected[c.descs[metricGardenShootForcedUpgradeImminent]], map[string]float64{
+		"name=expiring,project=dev,version=1.16.8":       1,
+		"name=supported,project=dev,version=1.17.4":      0,
+		"name=expired,project=dev,version=1.15.11":       1,
+		"name=no-auto-update,project=dev,version=1.16.8": 0,
+	})
+}
+
+func TestCollectShootAdmissionPluginMetrics(t *testing.T) {
+	var (
+		projectName = "dev"
+		configured  = newTestShoot("garden-dev", "configured", "uid-1")
+		defaulted   = newTestShoot("garden-dev", "defaulted", "uid-2")
+		c           = newTestCollector(t)
+	)
+	configured.Spec.Kubernetes.KubeAPIServer = &gardenv1beta1.KubeAPIServerConfig{
+		AdmissionPlugins: []gardenv1beta1.AdmissionPlugin{{Name: "PodNodeSelector"}, {Name: "AlwaysPullImages"}},
+	}
+	defaulted.Spec.Kubernetes.KubeAPIServer = &gardenv1beta1.KubeAPIServerConfig{}
+
+	collected := collectSeries(func(ch chan<- prometheus.Metric) {
+		c.collectShootKubeAPIServerMetrics(configured, &projectName, ch)
+		c.collectShootKubeAPIServerMetrics(defaulted, &projectName, ch)
+	})
+	// Shoots using only the default plugins are skipped.
+	assertValues(t, collected[c.descs[metricGardenShootAdmissionPluginInfo]], map[string]float64{
+		"name=configured,plugin=AlwaysPullImages,project=dev": 0,
+		"name=configured,plugin=PodNodeSelector,project=dev":  0,
+	})
+}
@@ -16,6 +16,8 @@ package metrics
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -23,7 +25,18 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
-const unknown = "unknown"
+const (
+	unknown = "unknown"
+
+	// Annotations to exclude a Shoot from reconciliation. They are not part of the
+	// API constants and defined by the gardenlet.
+	annotationShootIgnore           = "shoot.gardener.cloud/ignore"
+	annotationShootIgnoreDeprecated = "shoot.garden.sapcloud.io/ignore"
+
+	// Annotations which confirm the deletion of a Shoot. A Shoot cannot be deleted without them.
+	annotationConfirmationDeletion           = "confirmation.gardener.cloud/deletion"
+	annotationConfirmationDeletionDeprecated = "confirmation.garden.sapcloud.io/deletion"
+)
 
 var (
 	// ScrapeFailures is a metric, which counts the amount scrape issues grouped by kind.
@@ -46,6 +59,68 @@ func mapConditionStatus(status gardenv1beta1.ConditionStatus) float64 {
 	}
 }
 
+// conditionStatuses are the possible statuses of a condition, which are exposed as separate series.
+var conditionStatuses = []gardenv1beta1.ConditionStatus{
+	gardenv1beta1.ConditionTrue,
+	gardenv1beta1.ConditionFalse,
+	gardenv1beta1.ConditionUnknown,
+	gardenv1beta1.ConditionProgressing,
+}
+
+// newConditionStatusMetrics creates one metric per possible condition status. The metric of the
+// current status of the condition has the value 1, all others 0. The status is appended to the labels.
+func newConditionStatusMetrics(desc *prometheus.Desc, condition gardenv1beta1.Condition, labels ...string) ([]prometheus.Metric, error) {
+	metrics := make([]prometheus.Metric, 0, len(conditionStatuses))
+	for _, status := range conditionStatuses {
+		var value float64
+		if condition.Status == status {
+			value = 1
+		}
+		metric, err := prometheus.NewConstMetric(desc, prometheus.GaugeValue, value, append(labels, string(status))...)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
+
+func mapOperationState(state gardenv1beta1.LastOperationState) float64 {
+	switch state {
+	case gardenv1beta1.LastOperationStateSucceeded:
+		return 1
+	case gardenv1beta1.LastOperationStateProcessing:
+		return 2
+	case gardenv1beta1.LastOperationStatePending:
+		return 3
+	case gardenv1beta1.LastOperationStateAborted:
+		return 4
+	case gardenv1beta1.LastOperationStateError:
+		return 5
+	case gardenv1beta1.LastOperationStateFailed:
+		return 6
+	default:
+		return 0
+	}
+}
+
+// parseKubernetesVersion returns the major, minor and patch of a Kubernetes version like 1.18.2.
+func parseKubernetesVersion(version string) (int, int, int, error) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) != 3 {
+		return 0, 0, 0, fmt.Errorf("version %q is not in the format <major>.<minor>.<patch>", version)
+	}
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("version %q is not in the format <major>.<minor>.<patch>", version)
+		}
+		numbers[i] = number
+	}
+	return numbers[0], numbers[1], numbers[2], nil
+}
+
 func usedAsSeed(shoot *gardenv1beta1.Shoot) bool {
 	if shoot.Namespace != constants.GardenNamespace {
 		return false
@@ -57,6 +132,50 @@ func usedAsSeed(shoot *gardenv1beta1.Shoot) bool {
 	return true
 }
 
+// annotationEnabled checks if the first of the given annotation keys, which is present
+// on the object, has a true value. Later keys are used as fallback for deprecated annotations.
+func annotationEnabled(annotations map[string]string, keys ...string) bool {
+	for _, key := range keys {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+		enabled, _ := strconv.ParseBool(value)
+		return enabled
+	}
+	return false
+}
+
+// wantsBasicAuthentication checks if basic authentication is enabled for the kube-apiserver
+// of the given Shoot. It is enabled by default, if not explicitly disabled.
+func wantsBasicAuthentication(shoot *gardenv1beta1.Shoot) bool {
+	config := shoot.Spec.Kubernetes.KubeAPIServer
+	if config == nil || config.EnableBasicAuthentication == nil {
+		return true
+	}
+	return *config.EnableBasicAuthentication
+}
+
+// findPrimaryDNSProvider returns the primary DNS provider of the given list. If none is
+// marked as primary, the first one is returned to stay compatible with older Shoots.
+func findPrimaryDNSProvider(providers []gardenv1beta1.DNSProvider) *gardenv1beta1.DNSProvider {
+	for _, provider := range providers {
+		if provider.Primary != nil && *provider.Primary {
+			primaryProvider := provider
+			return &primaryProvider
+		}
+	}
+	if len(providers) > 0 {
+		return &providers[0]
+	}
+	return nil
+}
+
+// backupEntryName returns the name of the BackupEntry which belongs to the given Shoot.
+func backupEntryName(shoot *gardenv1beta1.Shoot) string {
+	return fmt.Sprintf("%s--%s", shoot.Status.TechnicalID, shoot.Status.UID)
+}
+
 func findProject(projects []*gardenv1beta1.Project, match string) (*string, error) {
 	var projectName string
 	for _, project := range projects {
@@ -0,0 +1,65 @@
+// Copyright (c) 2026 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+func TestWantsBasicAuthentication(t *testing.T) {
+	enabled, disabled := true, false
+	tests := []struct {
+		name   string
+		config *gardenv1beta1.KubeAPIServerConfig
+		want   bool
+	}{
+		{"no kube-apiserver config", nil, true},
+		{"not configured", &gardenv1beta1.KubeAPIServerConfig{}, true},
+		{"enabled", &gardenv1beta1.KubeAPIServerConfig{EnableBasicAuthentication: &enabled}, true},
+		{"disabled", &gardenv1beta1.KubeAPIServerConfig{EnableBasicAuthentication: &disabled}, false},
+	}
+	for _, tt := range tests {
+		shoot := &gardenv1beta1.Shoot{}
+		shoot.Spec.Kubernetes.KubeAPIServer = tt.config
+		if got := wantsBasicAuthentication(shoot); got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFindPrimaryDNSProvider(t *testing.T) {
+	primary := true
+	first, second := "aws-route53", "google-clouddns"
+	tests := []struct {
+		name      string
+		providers []gardenv1beta1.DNSProvider
+		want      *string
+	}{
+		{"no providers", nil, nil},
+		{"no primary provider", []gardenv1beta1.DNSProvider{{Type: &first}, {Type: &second}}, &first},
+		{"primary provider", []gardenv1beta1.DNSProvider{{Type: &first}, {Type: &second, Primary: &primary}}, &second},
+	}
+	for _, tt := range tests {
+		got := findPrimaryDNSProvider(tt.providers)
+		switch {
+		case tt.want == nil && got != nil:
+			t.Errorf("%s: got provider %q, want none", tt.name, *got.Type)
+		case tt.want != nil && (got == nil || *got.Type != *tt.want):
+			t.Errorf("%s: got %v, want provider %q", tt.name, got, *tt.want)
+		}
+	}
+}
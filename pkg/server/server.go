@@ -29,13 +29,15 @@ var landingPage = []byte(`<html>
 <body>
 <h1>Gardener Metrics Exporter</h1>
 <p><a href='/metrics'>Metrics</a></p>
+<p><a href='/explain'>Explain</a> the metrics of a Shoot via <code>/explain?shoot=&lt;namespace&gt;/&lt;name&gt;</code></p>
 </body>
 </html>
 `)
 
 // Serve start the webserver and configure gracefull shut downs.
-func Serve(ctx context.Context, bindAddress string, port int, logger *logrus.Logger, stopCh chan struct{}) {
+func Serve(ctx context.Context, bindAddress string, port int, explainHandler http.Handler, logger *logrus.Logger, stopCh chan struct{}) {
 	http.Handle("/metrics", promhttp.Handler())
+	http.Handle("/explain", explainHandler)
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "Content-Type: text/html; charset=utf-8")
 		w.Write(landingPage)